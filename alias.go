@@ -0,0 +1,149 @@
+package gotr
+
+import (
+	"slices"
+	"sort"
+
+	"github.com/leoviggiano/gotr/internal/catalog"
+)
+
+// WithNormalizedWhitespace makes texts match as keys whatever their whitespace: runs of spaces,
+// tabs and newlines count as a single space and leading and trailing whitespace is ignored.
+func WithNormalizedWhitespace() option {
+	return func(t *translator) error {
+		t.normalizeWhitespace = true
+		t.reindex()
+		return nil
+	}
+}
+
+// textKey returns the text-as-key entry of text in context.
+func (t *translator) textKey(context, text string) string {
+	if t.normalizeWhitespace {
+		text = catalog.NormalizeWhitespace(text)
+	}
+
+	if context == "" {
+		return text
+	}
+
+	return context + contextSeparator + text
+}
+
+// linkTexts points the text-as-key entries of every form of source, a template of the default
+// locale, to tpl. A text with a message context is also looked up without it while unique holds it.
+// Texts that are key paths themselves keep their template.
+func (t *translator) linkTexts(templates map[string]template, source, tpl template, unique map[string]bool) {
+	for _, text := range source.texts() {
+		keys := []string{t.textKey(source.metadata.MessageContext, text)}
+		if plain := t.textKey("", text); source.metadata.MessageContext != "" && unique[plain] {
+			keys = append(keys, plain)
+		}
+
+		for _, key := range keys {
+			if existing, ok := templates[key]; ok && existing.path == key {
+				continue
+			}
+
+			templates[key] = tpl
+		}
+	}
+}
+
+// uniqueTexts returns the texts of the default locale, without their message context, that belong
+// to a single key path.
+func (t *translator) uniqueTexts() map[string]bool {
+	owners := make(map[string]string)
+	unique := make(map[string]bool)
+	for key, tpl := range t.templates[t.defaultIdentifier] {
+		if tpl.path != key {
+			continue
+		}
+
+		for _, text := range tpl.texts() {
+			plain := t.textKey("", text)
+			if owner, ok := owners[plain]; ok {
+				unique[plain] = unique[plain] && owner == key
+				continue
+			}
+
+			owners[plain] = key
+			unique[plain] = true
+		}
+	}
+
+	return unique
+}
+
+// texts returns the distinct forms of a template, singular first.
+func (t template) texts() []string {
+	var texts []string
+	for _, text := range []string{t.Singular, t.Plural, t.None} {
+		if text != "" && !slices.Contains(texts, text) {
+			texts = append(texts, text)
+		}
+	}
+
+	return texts
+}
+
+// relink rebuilds what derives from the templates of identifier once they changed: its text-as-key
+// entries or, for the default locale, everything reindex rebuilds.
+func (t *translator) relink(identifier string) {
+	if identifier == t.defaultIdentifier {
+		t.reindex()
+		return
+	}
+
+	t.linkAliases(identifier)
+}
+
+// reindex rebuilds the text-as-key entries of every locale, the collisions and the pseudo locale, so
+// they don't depend on the order locales were registered in.
+func (t *translator) reindex() {
+	t.linkAllAliases()
+	t.collisions = t.findCollisions()
+	t.generatePseudoLocale()
+}
+
+// linkAllAliases rebuilds the text-as-key entries of every locale but the pseudo locale, which is
+// generated with its own.
+func (t *translator) linkAllAliases() {
+	for identifier := range t.templates {
+		if identifier != t.pseudoIdentifier {
+			t.linkAliases(identifier)
+		}
+	}
+}
+
+// linkAliases rebuilds the text-as-key entries of identifier from its key paths: the default locale
+// is addressed by the texts of its own forms, in their message context, and the other locales by
+// the default locale's.
+func (t *translator) linkAliases(identifier string) {
+	templates := t.templates[identifier]
+
+	var paths []string
+	for key, tpl := range templates {
+		if tpl.path != key {
+			delete(templates, key)
+			continue
+		}
+
+		paths = append(paths, key)
+	}
+	sort.Strings(paths)
+
+	defaultTemplates := t.templates[t.defaultIdentifier]
+	unique := t.uniqueTexts()
+	for _, path := range paths {
+		tpl := templates[path]
+		if identifier == t.defaultIdentifier {
+			t.linkTexts(templates, tpl, tpl, unique)
+			continue
+		}
+
+		if defaultTemplate, ok := defaultTemplates[path]; ok {
+			t.linkTexts(templates, defaultTemplate, tpl, unique)
+		}
+	}
+}
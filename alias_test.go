@@ -0,0 +1,198 @@
+package gotr
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTranslator_GetByForm(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, dir, "en.json", `{
+		"armor": {
+			"singular": "{{.Name}} has {{.Count}} Armor.",
+			"plural": "{{.Name}} has {{.Count}} Armors.",
+			"none": "{{.Name}} has no Armor."
+		},
+		"welcome": "Welcome,\n  {{.Name}}!",
+		"greeting": "hello",
+		"hello": "Hello"
+	}`)
+	writeFile(t, dir, "pt.json", `{
+		"armor": {
+			"singular": "{{.Name}} tem {{.Count}} Armadura.",
+			"plural": "{{.Name}} tem {{.Count}} Armaduras.",
+			"none": "{{.Name}} não tem Armadura."
+		},
+		"welcome": "Bem-vindo, {{.Name}}!",
+		"greeting": "olá",
+		"hello": "Olá"
+	}`)
+
+	tt := []struct {
+		name      string
+		normalize bool
+		args      Args
+		expected  string
+	}{
+		{
+			name:     "singular",
+			args:     Args{Identifier: "pt", Localizer: "{{.Name}} has {{.Count}} Armor.", Args: map[string]any{"Name": "John", "Count": 1}, Count: 1},
+			expected: "John tem 1 Armadura.",
+		},
+		{
+			name:     "plural",
+			args:     Args{Identifier: "pt", Localizer: "{{.Name}} has {{.Count}} Armors.", Args: map[string]any{"Name": "John", "Count": 3}, Count: 3},
+			expected: "John tem 3 Armaduras.",
+		},
+		{
+			name:     "none",
+			args:     Args{Identifier: "pt", Localizer: "{{.Name}} has no Armor.", Args: map[string]any{"Name": "John"}},
+			expected: "John não tem Armadura.",
+		},
+		{
+			name:     "form picked by count",
+			args:     Args{Identifier: "pt", Localizer: "{{.Name}} has no Armor.", Args: map[string]any{"Name": "John", "Count": 2}, Count: 2},
+			expected: "John tem 2 Armaduras.",
+		},
+		{
+			name:     "whitespace kept",
+			args:     Args{Identifier: "pt", Localizer: "Welcome, {{.Name}}!", Args: map[string]any{"Name": "John"}},
+			expected: "Welcome, John!",
+		},
+		{
+			name:      "whitespace normalized",
+			normalize: true,
+			args:      Args{Identifier: "pt", Localizer: " Welcome,\t{{.Name}}! ", Args: map[string]any{"Name": "John"}},
+			expected:  "Bem-vindo, John!",
+		},
+		{
+			name:     "text matching a key path",
+			args:     Args{Identifier: "pt", Localizer: "hello"},
+			expected: "Olá",
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			options := []option{WithDefault("en", filepath.Join(dir, "en.json"))}
+			if tc.normalize {
+				options = append([]option{WithNormalizedWhitespace()}, options...)
+			}
+
+			translator, err := NewTranslator(options...)
+			require.NoError(t, err)
+			require.NoError(t, translator.Register("pt", filepath.Join(dir, "pt.json")))
+
+			require.Equal(t, tc.expected, translator.Get(tc.args))
+		})
+	}
+}
+
+func TestTranslator_CollisionsByForm(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, dir, "en.json", `{
+		"armor": {"singular": "Armor", "plural": "Armors", "none": "No armor"},
+		"armors": "Armors",
+		"empty": {"singular": "Nothing", "none": "No  armor"}
+	}`)
+
+	t.Run("exact", func(t *testing.T) {
+		translator, err := NewTranslator(WithDefault("en", filepath.Join(dir, "en.json")))
+		require.NoError(t, err)
+
+		require.Equal(t, []Collision{
+			{Identifier: "en", Text: "Armors", Keys: []string{"armor", "armors"}},
+		}, translator.Collisions())
+	})
+
+	t.Run("normalized", func(t *testing.T) {
+		translator, err := NewTranslator(WithNormalizedWhitespace(), WithDefault("en", filepath.Join(dir, "en.json")))
+		require.NoError(t, err)
+
+		require.Equal(t, []Collision{
+			{Identifier: "en", Text: "Armors", Keys: []string{"armor", "armors"}},
+			{Identifier: "en", Text: "No armor", Keys: []string{"armor", "empty"}},
+		}, translator.Collisions())
+	})
+}
+
+func TestTranslator_RegistrationOrder(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, dir, "en.json", `{"hello": "Hello"}`)
+	writeFile(t, dir, "en_items.json", `{"armor": {"singular": "Armor", "plural": "Armors", "none": "No armor"}}`)
+	writeFile(t, dir, "pt.json", `{"hello": "Olá", "armor": {"singular": "Armadura", "plural": "Armaduras", "none": "Sem armadura"}}`)
+	en, items, pt := filepath.Join(dir, "en.json"), filepath.Join(dir, "en_items.json"), filepath.Join(dir, "pt.json")
+
+	tt := []struct {
+		name     string
+		register func(t *testing.T) Translator
+	}{
+		{
+			name: "default first",
+			register: func(t *testing.T) Translator {
+				translator, err := NewTranslator(WithDefault("en", en), WithDefault("en", items))
+				require.NoError(t, err)
+				require.NoError(t, translator.Register("pt", pt))
+				return translator
+			},
+		},
+		{
+			name: "default last",
+			register: func(t *testing.T) Translator {
+				translator, err := NewTranslator(WithDefaultIdentifier("en"))
+				require.NoError(t, err)
+				require.NoError(t, translator.Register("pt", pt))
+				require.NoError(t, translator.Register("en", items))
+				require.NoError(t, translator.Register("en", en))
+				return translator
+			},
+		},
+		{
+			name: "before the default option",
+			register: func(t *testing.T) Translator {
+				registerPT := func(tr *translator) error { return tr.Register("pt", pt) }
+
+				translator, err := NewTranslator(registerPT, WithDefault("en", items), WithDefault("en", en))
+				require.NoError(t, err)
+				return translator
+			},
+		},
+		{
+			name: "default replaced",
+			register: func(t *testing.T) Translator {
+				translator, err := NewTranslator(WithDefault("en", en))
+				require.NoError(t, err)
+				require.NoError(t, translator.Register("pt", pt))
+				require.NoError(t, translator.Replace("en", items))
+				require.NoError(t, translator.Register("en", en))
+				return translator
+			},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			translator := tc.register(t)
+
+			require.Equal(t, "Olá", translator.Get(Args{Identifier: "pt", Localizer: "Hello"}))
+			require.Equal(t, "Sem armadura", translator.Get(Args{Identifier: "pt", Localizer: "No armor"}))
+			require.Equal(t, "Armaduras", translator.Get(Args{Identifier: "pt", Localizer: "Armor", Count: 2}))
+		})
+	}
+}
+
+func TestWithNormalizedWhitespace(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, dir, "en.json", `{"armor": {"singular": "Armor", "plural": "Armors", "none": "No  armor"}}`)
+	writeFile(t, dir, "pt.json", `{"armor": {"singular": "Armadura", "plural": "Armaduras", "none": "Sem armadura"}}`)
+
+	// Files registered before the option are reindexed.
+	translator, err := NewTranslator(WithDefault("en", filepath.Join(dir, "en.json")), WithNormalizedWhitespace())
+	require.NoError(t, err)
+	require.NoError(t, translator.Register("pt", filepath.Join(dir, "pt.json")))
+
+	require.Equal(t, "Sem armadura", translator.Get(Args{Identifier: "pt", Localizer: "No armor"}))
+	require.Equal(t, "Sem armadura", translator.Get(Args{Identifier: "pt", Localizer: "\nNo\tarmor "}))
+}
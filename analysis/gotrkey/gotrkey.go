@@ -0,0 +1,240 @@
+// Package gotrkey defines an analyzer that checks gotr.Args literals against a translation catalog.
+//
+// The analyzer reports Localizer constants that are neither a key path, a list nor a text of the catalog,
+// and Args map keys that do not match the placeholders of the referenced template.
+package gotrkey
+
+import (
+	"fmt"
+	"go/ast"
+	"go/constant"
+	"go/types"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/leoviggiano/gotr/internal/catalog"
+	"github.com/leoviggiano/gotr/internal/placeholder"
+	"github.com/leoviggiano/gotr/internal/scanner"
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/ast/inspector"
+)
+
+const gotrPath = "github.com/leoviggiano/gotr"
+
+var Analyzer = &analysis.Analyzer{
+	Name:     "gotrkey",
+	Doc:      "check that gotr.Args literals reference existing translation keys with matching arguments",
+	Run:      run,
+	Requires: []*analysis.Analyzer{inspect.Analyzer},
+}
+
+var (
+	catalogFiles string
+	pluralForms  = scanner.DefaultForms
+)
+
+func init() {
+	Analyzer.Flags.StringVar(&catalogFiles, "catalog", "", "comma-separated catalog files of the default locale")
+	Analyzer.Flags.Func("forms", "comma-separated singular, plural and none form names (default \"singular,plural,none\")", func(s string) error {
+		forms, err := scanner.ParseForms(s)
+		if err != nil {
+			return err
+		}
+
+		pluralForms = forms
+		return nil
+	})
+}
+
+type templates struct {
+	once    sync.Once
+	files   string
+	forms   scanner.Forms
+	entries map[string]catalog.Entry
+	texts   map[string]catalog.Entry
+	err     error
+}
+
+var (
+	loadedMu sync.Mutex
+	loaded   = map[string]*templates{}
+)
+
+// load reads the catalog files once per flag values.
+func load(files string, forms scanner.Forms) (*templates, error) {
+	loadedMu.Lock()
+	t, ok := loaded[files+";"+forms.String()]
+	if !ok {
+		t = &templates{files: files, forms: forms}
+		loaded[files+";"+forms.String()] = t
+	}
+	loadedMu.Unlock()
+
+	t.once.Do(func() {
+		t.entries = make(map[string]catalog.Entry)
+		t.texts = make(map[string]catalog.Entry)
+
+		for _, path := range strings.Split(files, ",") {
+			tree, err := catalog.Load(strings.TrimSpace(path))
+			if err != nil {
+				t.err = fmt.Errorf("%s: %w", path, err)
+				return
+			}
+
+			entries, err := catalog.Entries(tree, t.forms)
+			if err != nil {
+				t.err = fmt.Errorf("%s: %w", path, err)
+				return
+			}
+
+			for k, e := range entries {
+				t.entries[k] = e
+				for _, text := range e.Texts() {
+					t.texts[catalog.NormalizeWhitespace(text)] = e
+				}
+			}
+		}
+	})
+
+	return t, t.err
+}
+
+// lookup returns the entry at key or with key as a text, or the elements of the list at key.
+func (t *templates) lookup(key string) ([]catalog.Entry, bool) {
+	if e, ok := t.entries[key]; ok {
+		return []catalog.Entry{e}, true
+	}
+
+	if e, ok := t.texts[catalog.NormalizeWhitespace(key)]; ok {
+		return []catalog.Entry{e}, true
+	}
+
+	var elements []catalog.Entry
+	for _, path := range catalog.ListPaths(t.entries, key) {
+		elements = append(elements, t.entries[path])
+	}
+
+	return elements, len(elements) > 0
+}
+
+func run(pass *analysis.Pass) (any, error) {
+	if catalogFiles == "" {
+		return nil, fmt.Errorf("the -catalog flag is required")
+	}
+
+	tpls, err := load(catalogFiles, pluralForms)
+	if err != nil {
+		return nil, err
+	}
+
+	insp := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
+	insp.Preorder([]ast.Node{(*ast.CompositeLit)(nil)}, func(n ast.Node) {
+		lit := n.(*ast.CompositeLit)
+		if !isArgs(pass.TypesInfo.TypeOf(lit)) {
+			return
+		}
+
+		var localizer, args ast.Expr
+		for _, elt := range lit.Elts {
+			kv, ok := elt.(*ast.KeyValueExpr)
+			if !ok {
+				continue
+			}
+
+			switch ident, _ := kv.Key.(*ast.Ident); {
+			case ident == nil:
+			case ident.Name == "Localizer":
+				localizer = kv.Value
+			case ident.Name == "Args":
+				args = kv.Value
+			}
+		}
+
+		key, ok := constantString(pass, localizer)
+		if !ok {
+			return
+		}
+
+		entries, ok := tpls.lookup(key)
+		if !ok {
+			pass.Reportf(localizer.Pos(), "translation key %q not found in catalog", key)
+			return
+		}
+
+		checkArgs(pass, key, entries, args)
+	})
+
+	return nil, nil
+}
+
+// checkArgs compares the constant keys of an Args map literal with the placeholders of entries.
+func checkArgs(pass *analysis.Pass, key string, entries []catalog.Entry, args ast.Expr) {
+	lit, ok := args.(*ast.CompositeLit)
+	if !ok {
+		return
+	}
+
+	expected := make(map[string]struct{})
+	for _, entry := range entries {
+		for _, text := range entry.Forms {
+			for _, name := range placeholder.Names(text) {
+				expected[name] = struct{}{}
+			}
+		}
+	}
+
+	given := make(map[string]struct{})
+	for _, elt := range lit.Elts {
+		kv, ok := elt.(*ast.KeyValueExpr)
+		if !ok {
+			return
+		}
+
+		name, ok := constantString(pass, kv.Key)
+		if !ok {
+			return
+		}
+
+		given[name] = struct{}{}
+		if _, ok := expected[name]; !ok {
+			pass.Reportf(kv.Key.Pos(), "argument %q is not a placeholder of %q", name, key)
+		}
+	}
+
+	missing := []string{}
+	for name := range expected {
+		if _, ok := given[name]; !ok {
+			missing = append(missing, name)
+		}
+	}
+	sort.Strings(missing)
+
+	for _, name := range missing {
+		pass.Reportf(lit.Pos(), "placeholder %q of %q has no argument", name, key)
+	}
+}
+
+func constantString(pass *analysis.Pass, expr ast.Expr) (string, bool) {
+	if expr == nil {
+		return "", false
+	}
+
+	tv, ok := pass.TypesInfo.Types[expr]
+	if !ok || tv.Value == nil || tv.Value.Kind() != constant.String {
+		return "", false
+	}
+
+	return constant.StringVal(tv.Value), true
+}
+
+func isArgs(t types.Type) bool {
+	named, ok := t.(*types.Named)
+	if !ok {
+		return false
+	}
+
+	obj := named.Obj()
+	return obj.Name() == "Args" && obj.Pkg() != nil && obj.Pkg().Path() == gotrPath
+}
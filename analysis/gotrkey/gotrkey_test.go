@@ -0,0 +1,26 @@
+package gotrkey
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/tools/go/analysis/analysistest"
+)
+
+func TestAnalyzer(t *testing.T) {
+	testdata := analysistest.TestData()
+
+	err := Analyzer.Flags.Set("catalog", filepath.Join(testdata, "catalog.json"))
+	require.NoError(t, err)
+
+	analysistest.Run(t, testdata, Analyzer, "a")
+
+	t.Run("custom forms", func(t *testing.T) {
+		require.NoError(t, Analyzer.Flags.Set("catalog", filepath.Join(testdata, "forms.json")))
+		require.NoError(t, Analyzer.Flags.Set("forms", "one,other,zero"))
+		t.Cleanup(func() { require.NoError(t, Analyzer.Flags.Set("forms", "singular,plural,none")) })
+
+		analysistest.Run(t, testdata, Analyzer, "forms")
+	})
+}
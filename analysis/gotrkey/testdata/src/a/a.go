@@ -0,0 +1,24 @@
+package a
+
+import "github.com/leoviggiano/gotr"
+
+const welcome = "texts.welcome"
+
+func args(dynamic string) []gotr.Args {
+	return []gotr.Args{
+		{Localizer: "hello_world"},
+		{Localizer: "Hello World"},
+		{Localizer: "texts.missing"}, // want `translation key "texts.missing" not found in catalog`
+		{Localizer: dynamic},
+		{Localizer: welcome, Args: map[string]any{"Name": "John"}},
+		{Localizer: welcome, Args: map[string]any{"Nmae": "John"}}, // want `argument "Nmae" is not a placeholder of "texts.welcome"` `placeholder "Name" of "texts.welcome" has no argument`
+		{Localizer: "armor", Args: map[string]any{"Name": "John", "Count": 2}, Count: 2},
+		{Localizer: "armor", Args: map[string]any{"Name": "John"}}, // want `placeholder "Count" of "armor" has no argument`
+		{Localizer: "armor", Args: map[string]any{dynamic: "John"}},
+		{Localizer: "{{.Name}} has no Armor.", Args: map[string]any{"Name": "John", "Count": 0}},
+		{Localizer: "Hello   World"},
+		{Localizer: "tips"},
+		{Localizer: "tips", Args: map[string]any{"Name": "John"}},
+		{Localizer: "tips", Args: map[string]any{}}, // want `placeholder "Name" of "tips" has no argument`
+	}
+}
@@ -0,0 +1,10 @@
+package forms
+
+import "github.com/leoviggiano/gotr"
+
+func args() []gotr.Args {
+	return []gotr.Args{
+		{Localizer: "armor", Args: map[string]any{"Name": "John", "Count": 2}, Count: 2},
+		{Localizer: "armor.one"}, // want `translation key "armor.one" not found in catalog`
+	}
+}
@@ -0,0 +1,8 @@
+package gotr
+
+type Args struct {
+	Identifier string
+	Localizer  string
+	Args       map[string]any
+	Count      int
+}
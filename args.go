@@ -3,11 +3,14 @@ package gotr
 import (
 	"fmt"
 	"strings"
+
+	"github.com/leoviggiano/gotr/internal/keypath"
 )
 
 type Args struct {
 	Identifier string         // The identifier registered in the translator
-	Localizer  string         // JSON path or text
+	Localizer  string         // JSON path, with dots of keys escaped as "\.", or text
+	Context    string         // Message context ("msgctxt") telling apart messages with the same text
 	Args       map[string]any // Arguments to be replaced in the template
 	Count      int            // Count of the item if applies
 }
@@ -19,3 +22,9 @@ func (t Args) apply(originalString string) string {
 
 	return originalString
 }
+
+// Path joins the keys of segments into a Localizer path, escaping the dots that are part of a key:
+// Path("links", "example.com") is the path of the "example.com" key in the "links" object.
+func Path(segments ...string) string {
+	return keypath.Join(segments...)
+}
@@ -0,0 +1,197 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/leoviggiano/gotr"
+	"github.com/leoviggiano/gotr/internal/catalog"
+	"github.com/leoviggiano/gotr/internal/scanner"
+)
+
+type exportOptions struct {
+	version        string
+	original       string
+	sourceLanguage string
+	targetLanguage string
+	defaults       []string
+	files          []string
+	forms          scanner.Forms
+}
+
+type encoder func(w io.Writer, options exportOptions, messages []catalog.Message) error
+
+var encoders = map[string]encoder{
+	"xliff": func(w io.Writer, o exportOptions, messages []catalog.Message) error {
+		return catalog.EncodeXLIFF(w, o.version, o.original, o.sourceLanguage, o.targetLanguage, messages)
+	},
+	"po": func(w io.Writer, o exportOptions, messages []catalog.Message) error {
+		return catalog.EncodePO(w, o.targetLanguage, messages)
+	},
+	"android": func(w io.Writer, _ exportOptions, messages []catalog.Message) error {
+		return catalog.EncodeAndroid(w, messages)
+	},
+	"strings": func(w io.Writer, _ exportOptions, messages []catalog.Message) error {
+		return catalog.EncodeStrings(w, messages)
+	},
+	"stringsdict": func(w io.Writer, _ exportOptions, messages []catalog.Message) error {
+		return catalog.EncodeStringsdict(w, messages)
+	},
+	"arb": func(w io.Writer, o exportOptions, messages []catalog.Message) error {
+		return catalog.EncodeARB(w, o.targetLanguage, messages)
+	},
+	"properties": func(w io.Writer, _ exportOptions, messages []catalog.Message) error {
+		return catalog.EncodeProperties(w, messages)
+	},
+	"csv": func(w io.Writer, o exportOptions, _ []catalog.Message) error {
+		return exportSheet(w, o, gotr.Translator.ExportCSV)
+	},
+	"tsv": func(w io.Writer, o exportOptions, _ []catalog.Message) error {
+		return exportSheet(w, o, gotr.Translator.ExportTSV)
+	},
+}
+
+// runExport writes a locale in one of the supported formats. XLIFF pairs the default locale with the
+// given files; CSV and TSV write the default locale and every given file as its own column; the other
+// formats write the given files, or the default locale when none is given.
+func runExport(args []string, stdout, stderr io.Writer) int {
+	var defaults files
+
+	fs := flag.NewFlagSet("export", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	fs.Var(&defaults, "default", "catalog file of the default locale, used as source (repeatable)")
+	format := fs.String("format", "xliff", "output format: xliff, po, android, strings, stringsdict, arb, properties, csv or tsv")
+	version := fs.String("version", catalog.XLIFF12, "xliff version: 1.2 or 2.0")
+	sourceLanguage := fs.String("source-language", "en", "language of the default locale")
+	targetLanguage := fs.String("target-language", "", "language of the exported locale")
+	output := fs.String("o", "", "output file (default stdout)")
+	forms := formsFlag(fs)
+	fs.Usage = func() {
+		fmt.Fprintln(stderr, "usage: gotr export -default <file> [-default <file>...] [flags] [<file>...]")
+		fs.PrintDefaults()
+	}
+
+	if err := fs.Parse(args); err != nil {
+		return exitUsage
+	}
+
+	encode, ok := encoders[*format]
+	if len(defaults) == 0 || !ok {
+		fs.Usage()
+		return exitUsage
+	}
+
+	source, err := loadEntries(defaults, *forms)
+	if err != nil {
+		fmt.Fprintf(stderr, "gotr export: %v\n", err)
+		return exitUsage
+	}
+
+	target, err := loadEntries(fs.Args(), *forms)
+	if err != nil {
+		fmt.Fprintf(stderr, "gotr export: %v\n", err)
+		return exitUsage
+	}
+
+	original := filepath.Base(defaults[0])
+	if fs.NArg() > 0 {
+		original = filepath.Base(fs.Arg(0))
+	}
+
+	w := stdout
+	if *output != "" {
+		file, err := os.Create(*output)
+		if err != nil {
+			fmt.Fprintf(stderr, "gotr export: %v\n", err)
+			return exitFail
+		}
+		defer file.Close()
+
+		w = file
+	}
+
+	if *format != "xliff" && fs.NArg() == 0 {
+		target = source
+	}
+
+	messages := catalog.Messages(source, target)
+	options := exportOptions{
+		version:        *version,
+		original:       original,
+		sourceLanguage: *sourceLanguage,
+		targetLanguage: *targetLanguage,
+		defaults:       defaults,
+		files:          fs.Args(),
+		forms:          *forms,
+	}
+
+	if err := encode(w, options, messages); err != nil {
+		fmt.Fprintf(stderr, "gotr export: %v\n", err)
+		return exitFail
+	}
+
+	return exitOK
+}
+
+// loadEntries loads the entries of every file into a single locale, reading the plural blocks of forms.
+func loadEntries(paths []string, forms scanner.Forms) (map[string]catalog.Entry, error) {
+	entries := make(map[string]catalog.Entry)
+	for _, path := range paths {
+		tree, err := catalog.Load(path)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+
+		fileEntries, err := catalog.Entries(tree, forms)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+
+		for k, v := range fileEntries {
+			entries[k] = v
+		}
+	}
+
+	return entries, nil
+}
+
+// exportSheet writes the default locale and every file as a column of a sheet, each named after its
+// file without the extension, so that "gotr import -format csv" or "tsv" writes them back.
+func exportSheet(w io.Writer, o exportOptions, export func(gotr.Translator, io.Writer) error) error {
+	translator, err := gotr.NewTranslator(
+		gotr.WithPluralForms(o.forms.Singular, o.forms.Plural, o.forms.None),
+		gotr.WithDefault(localeName(o.defaults[0]), o.defaults[0]),
+	)
+	if err != nil {
+		return err
+	}
+
+	for _, path := range o.defaults[1:] {
+		if err := translator.Register(localeName(o.defaults[0]), path); err != nil {
+			return err
+		}
+	}
+
+	for _, path := range o.files {
+		if err := translator.Register(localeName(path), path); err != nil {
+			return err
+		}
+	}
+
+	return export(translator, w)
+}
+
+// localeName names the column of a catalog file after its locale, so the files of a split locale
+// share one, or after the file when its name holds no locale.
+func localeName(path string) string {
+	if identifier, ok := catalog.Identifier(catalog.FilePattern, path); ok {
+		return identifier
+	}
+
+	base := filepath.Base(path)
+	return strings.TrimSuffix(base, filepath.Ext(base))
+}
@@ -0,0 +1,116 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunExport(t *testing.T) {
+	defaults := []string{"-default", "../../translations/en_US.json", "-default", "../../translations/en_US_items.json"}
+
+	t.Run("xliff 1.2", func(t *testing.T) {
+		var stdout, stderr bytes.Buffer
+		code := run(append(append([]string{"export", "-target-language", "pt"}, defaults...), "../../translations/pt_BR.json"), &stdout, &stderr)
+		require.Equal(t, exitOK, code, stderr.String())
+		require.Contains(t, stdout.String(), `<file original="pt_BR.json" source-language="en" target-language="pt" datatype="plaintext">`)
+		require.Contains(t, stdout.String(), `<trans-unit id="items.equipments.armor[none]">`)
+	})
+
+	t.Run("xliff 2.0 to file", func(t *testing.T) {
+		output := filepath.Join(t.TempDir(), "pt.xlf")
+
+		var stdout, stderr bytes.Buffer
+		code := run(append(append([]string{"export", "-version", "2.0", "-o", output}, defaults...), "../../translations/pt_BR.json"), &stdout, &stderr)
+		require.Equal(t, exitOK, code, stderr.String())
+
+		data, err := os.ReadFile(output)
+		require.NoError(t, err)
+		require.Contains(t, string(data), `<unit id="hello_world">`)
+	})
+
+	t.Run("android", func(t *testing.T) {
+		var stdout, stderr bytes.Buffer
+		code := run(append(append([]string{"export", "-format", "android"}, defaults...), "../../translations/pt_BR.json"), &stdout, &stderr)
+		require.Equal(t, exitOK, code, stderr.String())
+		require.Contains(t, stdout.String(), `<string name="hello_world">Olá Mundo</string>`)
+		require.Contains(t, stdout.String(), `<item quantity="zero"><xliff:g id="Name">%2$s</xliff:g> não tem Armadura.</item>`)
+		require.Contains(t, stdout.String(), `<plurals name="items.consumables.health_potion">`)
+	})
+
+	t.Run("strings of the default locale", func(t *testing.T) {
+		var stdout, stderr bytes.Buffer
+		code := run(append([]string{"export", "-format", "strings"}, defaults...), &stdout, &stderr)
+		require.Equal(t, exitOK, code, stderr.String())
+		require.Contains(t, stdout.String(), `"hello_world" = "Hello World";`)
+		require.NotContains(t, stdout.String(), `"items.equipments.armor" =`)
+	})
+
+	t.Run("stringsdict", func(t *testing.T) {
+		var stdout, stderr bytes.Buffer
+		code := run(append(append([]string{"export", "-format", "stringsdict"}, defaults...), "../../translations/pt_BR.json"), &stdout, &stderr)
+		require.Equal(t, exitOK, code, stderr.String())
+		require.Contains(t, stdout.String(), "<key>items.equipments.armor</key>")
+		require.NotContains(t, stdout.String(), "hello_world")
+	})
+
+	t.Run("arb", func(t *testing.T) {
+		var stdout, stderr bytes.Buffer
+		code := run(append(append([]string{"export", "-format", "arb", "-target-language", "pt"}, defaults...), "../../translations/pt_BR.json"), &stdout, &stderr)
+		require.Equal(t, exitOK, code, stderr.String())
+		require.Contains(t, stdout.String(), `"@@locale": "pt"`)
+		require.Contains(t, stdout.String(), `"items.equipments.armor": "{Count, plural, `+
+			`=0{{Name} não tem Armadura.} =1{{Name} tem {Count} Armadura.} other{{Name} tem {Count} Armaduras.}}"`)
+	})
+
+	t.Run("properties", func(t *testing.T) {
+		var stdout, stderr bytes.Buffer
+		code := run(append(append([]string{"export", "-format", "properties"}, defaults...), "../../translations/pt_BR.json"), &stdout, &stderr)
+		require.Equal(t, exitOK, code, stderr.String())
+		require.Contains(t, stdout.String(), "hello_world = Ol\\u00e1 Mundo\n")
+		require.Contains(t, stdout.String(), "items.equipments.armor.none = {{.Name}} n\\u00e3o tem Armadura.\n")
+	})
+
+	t.Run("csv", func(t *testing.T) {
+		var stdout, stderr bytes.Buffer
+		code := run(append(append([]string{"export", "-format", "csv"}, defaults...), "../../translations/pt_BR.json"), &stdout, &stderr)
+		require.Equal(t, exitOK, code, stderr.String())
+		require.Contains(t, stdout.String(), "key,en_US,pt_BR\nhello_world,Hello World,Olá Mundo\n")
+	})
+
+	t.Run("csv split locale", func(t *testing.T) {
+		items := filepath.Join(t.TempDir(), "pt_BR_items.json")
+		require.NoError(t, os.WriteFile(items, []byte(`{"items": {"equipments": {"armor": {"singular": "1 Armadura"}}}}`), 0o600))
+
+		var stdout, stderr bytes.Buffer
+		code := run(append(append([]string{"export", "-format", "csv"}, defaults...), "../../translations/pt_BR.json", items), &stdout, &stderr)
+		require.Equal(t, exitOK, code, stderr.String())
+		require.Contains(t, stdout.String(), "key,en_US,pt_BR\n")
+		require.Contains(t, stdout.String(), "\nitems.equipments.armor.singular,{{.Name}} has {{.Count}} Armor.,1 Armadura\n")
+	})
+
+	t.Run("tsv", func(t *testing.T) {
+		var stdout, stderr bytes.Buffer
+		code := run(append(append([]string{"export", "-format", "tsv"}, defaults...), "../../translations/pt_BR.json"), &stdout, &stderr)
+		require.Equal(t, exitOK, code, stderr.String())
+		require.Contains(t, stdout.String(), "key\ten_US\tpt_BR\nhello_world\tHello World\tOlá Mundo\n")
+	})
+
+	t.Run("missing default", func(t *testing.T) {
+		var stdout, stderr bytes.Buffer
+		require.Equal(t, exitUsage, run([]string{"export", "../../translations/pt_BR.json"}, &stdout, &stderr))
+	})
+
+	t.Run("unsupported format", func(t *testing.T) {
+		var stdout, stderr bytes.Buffer
+		require.Equal(t, exitUsage, run(append([]string{"export", "-format", "docx"}, defaults...), &stdout, &stderr))
+	})
+
+	t.Run("unsupported version", func(t *testing.T) {
+		var stdout, stderr bytes.Buffer
+		require.Equal(t, exitFail, run(append([]string{"export", "-version", "3.0"}, defaults...), &stdout, &stderr))
+	})
+}
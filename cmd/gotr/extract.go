@@ -0,0 +1,95 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+
+	"github.com/leoviggiano/gotr/internal/catalog"
+	"github.com/leoviggiano/gotr/internal/extract"
+)
+
+func runExtract(args []string, stdout, stderr io.Writer) int {
+	var defaults files
+
+	fs := flag.NewFlagSet("extract", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	fs.Var(&defaults, "default", "catalog file of the default locale (repeatable)")
+	asJSON := fs.Bool("json", false, "print the report as JSON")
+	forms := formsFlag(fs)
+	fs.Usage = func() {
+		fmt.Fprintln(stderr, "usage: gotr extract -default <file> [-default <file>...] [-json] <package>...")
+		fs.PrintDefaults()
+	}
+
+	if err := fs.Parse(args); err != nil {
+		return exitUsage
+	}
+
+	if len(defaults) == 0 {
+		fs.Usage()
+		return exitUsage
+	}
+
+	patterns := fs.Args()
+	if len(patterns) == 0 {
+		patterns = []string{"./..."}
+	}
+
+	entries := make(map[string]catalog.Entry)
+	for _, path := range defaults {
+		tree, err := catalog.Load(path)
+		if err != nil {
+			fmt.Fprintf(stderr, "gotr extract: %s: %v\n", path, err)
+			return exitUsage
+		}
+
+		fileEntries, err := catalog.Entries(tree, *forms)
+		if err != nil {
+			fmt.Fprintf(stderr, "gotr extract: %s: %v\n", path, err)
+			return exitUsage
+		}
+
+		for k, v := range fileEntries {
+			entries[k] = v
+		}
+	}
+
+	dirs, err := extract.Dirs(patterns)
+	if err != nil {
+		fmt.Fprintf(stderr, "gotr extract: %v\n", err)
+		return exitUsage
+	}
+
+	usages, err := extract.Extract(dirs)
+	if err != nil {
+		fmt.Fprintf(stderr, "gotr extract: %v\n", err)
+		return exitUsage
+	}
+
+	report := extract.Compare(usages, entries)
+
+	if *asJSON {
+		enc := json.NewEncoder(stdout)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(report); err != nil {
+			fmt.Fprintf(stderr, "gotr extract: %v\n", err)
+			return exitUsage
+		}
+	} else {
+		for _, usage := range report.Missing {
+			fmt.Fprintf(stdout, "%s: key %q is not in the catalog\n", usage.Pos, usage.Key)
+		}
+
+		for _, key := range report.Unused {
+			fmt.Fprintf(stdout, "key %q is never used\n", key)
+		}
+	}
+
+	if len(report.Missing) > 0 || len(report.Unused) > 0 {
+		return exitFail
+	}
+
+	return exitOK
+}
@@ -0,0 +1,45 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/leoviggiano/gotr/internal/extract"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunExtract(t *testing.T) {
+	defaults := []string{"-default", "../../translations/en_US.json", "-default", "../../translations/en_US_items.json"}
+	app := "../../internal/extract/testdata/app"
+
+	t.Run("report", func(t *testing.T) {
+		var stdout, stderr bytes.Buffer
+		code := run(append(append([]string{"extract"}, defaults...), app), &stdout, &stderr)
+		require.Equal(t, exitFail, code)
+		require.Contains(t, stdout.String(), "app.go:19:32: key \"texts.missing\" is not in the catalog\n")
+		require.Contains(t, stdout.String(), "key \"texts.goodbye\" is never used\n")
+	})
+
+	t.Run("json output", func(t *testing.T) {
+		var stdout, stderr bytes.Buffer
+		code := run(append(append([]string{"extract", "-json"}, defaults...), app), &stdout, &stderr)
+		require.Equal(t, exitFail, code)
+
+		var report extract.Report
+		require.NoError(t, json.Unmarshal(stdout.Bytes(), &report))
+		require.Len(t, report.Missing, 1)
+		require.Equal(t, "texts.missing", report.Missing[0].Key)
+		require.Contains(t, report.Unused, "hello_world2")
+	})
+
+	t.Run("missing default", func(t *testing.T) {
+		var stdout, stderr bytes.Buffer
+		require.Equal(t, exitUsage, run([]string{"extract", app}, &stdout, &stderr))
+	})
+
+	t.Run("invalid package", func(t *testing.T) {
+		var stdout, stderr bytes.Buffer
+		require.Equal(t, exitUsage, run(append(append([]string{"extract"}, defaults...), "./invalid"), &stdout, &stderr))
+	})
+}
@@ -0,0 +1,114 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/leoviggiano/gotr"
+	"github.com/leoviggiano/gotr/internal/catalog"
+)
+
+func runImport(args []string, stdout, stderr io.Writer) int {
+	fs := flag.NewFlagSet("import", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	format := fs.String("format", "xliff", "input format: xliff, csv or tsv")
+	target := fs.String("target", "", "JSON catalog the xliff translations are merged into")
+	output := fs.String("o", "", "output file (default: overwrite -target)")
+	dir := fs.String("dir", ".", "directory of the JSON catalogs the csv or tsv columns are merged into")
+	forms := formsFlag(fs)
+	fs.Usage = func() {
+		fmt.Fprintln(stderr, "usage: gotr import -target <file> [-o <file>] <file>")
+		fmt.Fprintln(stderr, "       gotr import -format csv|tsv [-dir <dir>] <file>")
+		fs.PrintDefaults()
+	}
+
+	if err := fs.Parse(args); err != nil {
+		return exitUsage
+	}
+
+	if *format == "csv" && fs.NArg() == 1 {
+		return importSheet(fs.Arg(0), *dir, gotr.ImportCSV, stdout, stderr)
+	}
+
+	if *format == "tsv" && fs.NArg() == 1 {
+		return importSheet(fs.Arg(0), *dir, gotr.ImportTSV, stdout, stderr)
+	}
+
+	if *target == "" || fs.NArg() != 1 || *format != "xliff" {
+		fs.Usage()
+		return exitUsage
+	}
+
+	input, err := os.Open(fs.Arg(0))
+	if err != nil {
+		fmt.Fprintf(stderr, "gotr import: %v\n", err)
+		return exitUsage
+	}
+	defer input.Close()
+
+	translations, err := catalog.DecodeXLIFF(input)
+	if err != nil {
+		fmt.Fprintf(stderr, "gotr import: %s: %v\n", fs.Arg(0), err)
+		return exitUsage
+	}
+
+	obj, err := catalog.LoadObject(*target)
+	if err != nil {
+		fmt.Fprintf(stderr, "gotr import: %s: %v\n", *target, err)
+		return exitUsage
+	}
+
+	for _, t := range translations {
+		obj.Set(t.Path(*forms), t.Text)
+	}
+
+	var buf bytes.Buffer
+	if err := obj.Encode(&buf); err != nil {
+		fmt.Fprintf(stderr, "gotr import: %v\n", err)
+		return exitFail
+	}
+
+	path := *output
+	if path == "" {
+		path = *target
+	}
+
+	if err := os.WriteFile(path, buf.Bytes(), 0o644); err != nil {
+		fmt.Fprintf(stderr, "gotr import: %v\n", err)
+		return exitFail
+	}
+
+	fmt.Fprintf(stdout, "%d translations merged into %s\n", len(translations), path)
+	return exitOK
+}
+
+// importSheet writes every column of a sheet into the JSON catalogs of its locale in dir.
+func importSheet(path, dir string, importer func(io.Reader, string) ([]string, error), stdout, stderr io.Writer) int {
+	input, err := os.Open(path)
+	if err != nil {
+		fmt.Fprintf(stderr, "gotr import: %v\n", err)
+		return exitUsage
+	}
+	defer input.Close()
+
+	paths, err := importer(input, dir)
+	if errors.Is(err, catalog.ErrInvalidCSV) {
+		fmt.Fprintf(stderr, "gotr import: %s: %v\n", path, err)
+		return exitUsage
+	}
+
+	if err != nil {
+		fmt.Fprintf(stderr, "gotr import: %v\n", err)
+		return exitFail
+	}
+
+	for _, p := range paths {
+		fmt.Fprintf(stdout, "%s merged into %s\n", path, p)
+	}
+
+	return exitOK
+}
@@ -0,0 +1,166 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunImport(t *testing.T) {
+	dir := t.TempDir()
+	target := filepath.Join(dir, "pt_BR.json")
+	err := os.WriteFile(target, []byte(`{
+    "texts": {
+        "welcome": "Bem-vindo!",
+        "goodbye": "Até logo!"
+    },
+    "hello_world": "Olá"
+}
+`), 0o600)
+	require.NoError(t, err)
+
+	xliff := filepath.Join(dir, "pt.xlf")
+	err = os.WriteFile(xliff, []byte(`<?xml version="1.0" encoding="UTF-8"?>
+<xliff xmlns="urn:oasis:names:tc:xliff:document:1.2" version="1.2">
+  <file original="pt_BR.json" source-language="en" target-language="pt" datatype="plaintext">
+    <body>
+      <trans-unit id="hello_world">
+        <source>Hello World</source>
+        <target>Olá Mundo</target>
+      </trans-unit>
+      <trans-unit id="hello_world2">
+        <source>Hello World 2</source>
+        <target>Olá Mundo 2</target>
+      </trans-unit>
+      <trans-unit id="items.armor[singular]">
+        <source>{{.Count}} Armor</source>
+        <target>{{.Count}} Armadura</target>
+      </trans-unit>
+      <trans-unit id="texts.goodbye">
+        <source>Goodbye!</source>
+      </trans-unit>
+    </body>
+  </file>
+</xliff>
+`), 0o600)
+	require.NoError(t, err)
+
+	t.Run("success", func(t *testing.T) {
+		var stdout, stderr bytes.Buffer
+		code := run([]string{"import", "-target", target, xliff}, &stdout, &stderr)
+		require.Equal(t, exitOK, code, stderr.String())
+		require.Equal(t, "3 translations merged into "+target+"\n", stdout.String())
+
+		data, err := os.ReadFile(target)
+		require.NoError(t, err)
+		require.Equal(t, `{
+    "texts": {
+        "welcome": "Bem-vindo!",
+        "goodbye": "Até logo!"
+    },
+    "hello_world": "Olá Mundo",
+    "hello_world2": "Olá Mundo 2",
+    "items": {
+        "armor": {
+            "singular": "{{.Count}} Armadura"
+        }
+    }
+}
+`, string(data))
+	})
+
+	t.Run("new catalog", func(t *testing.T) {
+		output := filepath.Join(dir, "new.json")
+
+		var stdout, stderr bytes.Buffer
+		code := run([]string{"import", "-target", filepath.Join(dir, "missing.json"), "-o", output, xliff}, &stdout, &stderr)
+		require.Equal(t, exitOK, code, stderr.String())
+
+		data, err := os.ReadFile(output)
+		require.NoError(t, err)
+		require.True(t, strings.HasPrefix(string(data), "{\n    \"hello_world\": \"Olá Mundo\","))
+	})
+
+	t.Run("missing target", func(t *testing.T) {
+		var stdout, stderr bytes.Buffer
+		require.Equal(t, exitUsage, run([]string{"import", xliff}, &stdout, &stderr))
+	})
+
+	t.Run("invalid xliff", func(t *testing.T) {
+		var stdout, stderr bytes.Buffer
+		require.Equal(t, exitUsage, run([]string{"import", "-target", target, target}, &stdout, &stderr))
+	})
+
+	t.Run("invalid target", func(t *testing.T) {
+		var stdout, stderr bytes.Buffer
+		require.Equal(t, exitUsage, run([]string{"import", "-target", xliff, xliff}, &stdout, &stderr))
+	})
+}
+
+func TestRunImportCSV(t *testing.T) {
+	dir := t.TempDir()
+	sheet := filepath.Join(dir, "sheet.csv")
+	err := os.WriteFile(sheet, []byte("key,en_US,pt_BR\n"+
+		"hello_world,Hello World,Olá Mundo\n"+
+		"items.armor.singular,{{.Count}} Armor,{{.Count}} Armadura\n"+
+		"texts.goodbye,Goodbye!,\n"), 0o600)
+	require.NoError(t, err)
+
+	t.Run("success", func(t *testing.T) {
+		var stdout, stderr bytes.Buffer
+		code := run([]string{"import", "-format", "csv", "-dir", dir, sheet}, &stdout, &stderr)
+		require.Equal(t, exitOK, code, stderr.String())
+		require.Equal(t, sheet+" merged into "+filepath.Join(dir, "en_US.json")+"\n"+
+			sheet+" merged into "+filepath.Join(dir, "pt_BR.json")+"\n", stdout.String())
+
+		data, err := os.ReadFile(filepath.Join(dir, "pt_BR.json"))
+		require.NoError(t, err)
+		require.Equal(t, `{
+    "hello_world": "Olá Mundo",
+    "items": {
+        "armor": {
+            "singular": "{{.Count}} Armadura"
+        }
+    }
+}
+`, string(data))
+	})
+
+	t.Run("tsv", func(t *testing.T) {
+		tsv := filepath.Join(dir, "sheet.tsv")
+		err := os.WriteFile(tsv, []byte("key\tes\nhello_world\tHola, Mundo\n"), 0o600)
+		require.NoError(t, err)
+
+		var stdout, stderr bytes.Buffer
+		code := run([]string{"import", "-format", "tsv", "-dir", dir, tsv}, &stdout, &stderr)
+		require.Equal(t, exitOK, code, stderr.String())
+		require.Equal(t, tsv+" merged into "+filepath.Join(dir, "es.json")+"\n", stdout.String())
+
+		data, err := os.ReadFile(filepath.Join(dir, "es.json"))
+		require.NoError(t, err)
+		require.Equal(t, "{\n    \"hello_world\": \"Hola, Mundo\"\n}\n", string(data))
+	})
+
+	t.Run("invalid sheet", func(t *testing.T) {
+		var stdout, stderr bytes.Buffer
+		require.Equal(t, exitUsage, run([]string{"import", "-format", "csv", "-dir", dir, filepath.Join(dir, "pt_BR.json")}, &stdout, &stderr))
+	})
+
+	t.Run("locale with a path separator", func(t *testing.T) {
+		outside := filepath.Join(dir, "outside.csv")
+		err := os.WriteFile(outside, []byte("key,../en_US\nhello_world,Hello\n"), 0o600)
+		require.NoError(t, err)
+
+		var stdout, stderr bytes.Buffer
+		require.Equal(t, exitUsage, run([]string{"import", "-format", "csv", "-dir", dir, outside}, &stdout, &stderr))
+	})
+
+	t.Run("missing sheet", func(t *testing.T) {
+		var stdout, stderr bytes.Buffer
+		require.Equal(t, exitUsage, run([]string{"import", "-format", "csv", filepath.Join(dir, "missing.csv")}, &stdout, &stderr))
+	})
+}
@@ -0,0 +1,108 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+
+	"github.com/leoviggiano/gotr/internal/catalog"
+	"github.com/leoviggiano/gotr/internal/lint"
+)
+
+func runLint(args []string, stdout, stderr io.Writer) int {
+	var defaults files
+
+	fs := flag.NewFlagSet("lint", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	fs.Var(&defaults, "default", "catalog file of the default locale (repeatable)")
+	asJSON := fs.Bool("json", false, "print issues as JSON")
+	forms := formsFlag(fs)
+	fs.Usage = func() {
+		fmt.Fprintln(stderr, "usage: gotr lint -default <file> [-default <file>...] [-json] <file>...")
+		fs.PrintDefaults()
+	}
+
+	if err := fs.Parse(args); err != nil {
+		return exitUsage
+	}
+
+	if len(defaults) == 0 {
+		fs.Usage()
+		return exitUsage
+	}
+
+	defaultCatalogs, err := loadCatalogs(defaults)
+	if err != nil {
+		fmt.Fprintf(stderr, "gotr lint: %v\n", err)
+		return exitUsage
+	}
+
+	targetCatalogs, err := loadCatalogs(fs.Args())
+	if err != nil {
+		fmt.Fprintf(stderr, "gotr lint: %v\n", err)
+		return exitUsage
+	}
+
+	issues, err := lint.Lint(defaultCatalogs, targetCatalogs, *forms)
+	if err != nil {
+		fmt.Fprintf(stderr, "gotr lint: %v\n", err)
+		return exitUsage
+	}
+
+	if *asJSON {
+		if issues == nil {
+			issues = []lint.Issue{}
+		}
+
+		enc := json.NewEncoder(stdout)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(issues); err != nil {
+			fmt.Fprintf(stderr, "gotr lint: %v\n", err)
+			return exitUsage
+		}
+	} else {
+		for _, issue := range issues {
+			fmt.Fprintln(stdout, formatIssue(issue))
+		}
+	}
+
+	if len(issues) > 0 {
+		return exitFail
+	}
+
+	return exitOK
+}
+
+func loadCatalogs(paths []string) ([]lint.Catalog, error) {
+	catalogs := make([]lint.Catalog, 0, len(paths))
+	for _, path := range paths {
+		tree, err := catalog.Load(path)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+
+		catalogs = append(catalogs, lint.Catalog{File: path, Locale: localeOf(path), Tree: tree})
+	}
+
+	return catalogs, nil
+}
+
+func formatIssue(issue lint.Issue) string {
+	switch issue.Kind {
+	case lint.MissingKey:
+		return fmt.Sprintf("%s: missing key %q", issue.File, issue.Key)
+	case lint.ExtraKey:
+		return fmt.Sprintf("%s: extra key %q", issue.File, issue.Key)
+	case lint.MissingPluralForm:
+		return fmt.Sprintf("%s: key %q is missing plural form %q", issue.File, issue.Key, issue.Form)
+	case lint.EmptyValue:
+		if issue.Form != "" {
+			return fmt.Sprintf("%s: key %q has an empty %q form", issue.File, issue.Key, issue.Form)
+		}
+
+		return fmt.Sprintf("%s: key %q has an empty value", issue.File, issue.Key)
+	default:
+		return fmt.Sprintf("%s: %s %q", issue.File, issue.Kind, issue.Key)
+	}
+}
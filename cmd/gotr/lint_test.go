@@ -0,0 +1,89 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/leoviggiano/gotr/internal/lint"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunLint(t *testing.T) {
+	defaults := []string{"-default", "../../translations/en_US.json", "-default", "../../translations/en_US_items.json"}
+
+	t.Run("success", func(t *testing.T) {
+		var stdout, stderr bytes.Buffer
+		code := run(append([]string{"lint"}, defaults...), &stdout, &stderr)
+		require.Equal(t, exitOK, code)
+		require.Empty(t, stdout.String())
+	})
+
+	t.Run("issues", func(t *testing.T) {
+		var stdout, stderr bytes.Buffer
+		code := run(append(append([]string{"lint"}, defaults...), "../../translations/pt_BR.json"), &stdout, &stderr)
+		require.Equal(t, exitFail, code)
+		require.Equal(t, "../../translations/pt_BR.json: missing key \"hello_world2\"\n", stdout.String())
+	})
+
+	t.Run("json output", func(t *testing.T) {
+		var stdout, stderr bytes.Buffer
+		code := run(append(append([]string{"lint", "-json"}, defaults...), "../../translations/pt_BR.json"), &stdout, &stderr)
+		require.Equal(t, exitFail, code)
+
+		var issues []lint.Issue
+		require.NoError(t, json.Unmarshal(stdout.Bytes(), &issues))
+		require.Equal(t, []lint.Issue{{File: "../../translations/pt_BR.json", Key: "hello_world2", Kind: lint.MissingKey}}, issues)
+	})
+
+	t.Run("split locale", func(t *testing.T) {
+		dir := t.TempDir()
+		pt := filepath.Join(dir, "pt_BR.json")
+		items := filepath.Join(dir, "pt_BR_items.json")
+		require.NoError(t, os.WriteFile(pt, []byte(`{"hello_world": "Olá Mundo", "texts": {"welcome": "Bem-vindo!", "goodbye": "Até logo!"}}`), 0o600))
+		require.NoError(t, os.WriteFile(items, []byte(`{"items": {
+			"equipments": {"armor": {"singular": "1 Armadura", "plural": "{{.Count}} Armaduras", "none": "Sem Armadura"}},
+			"consumables": {
+				"health-potion": {"singular": "1 Poção", "plural": "{{.Count}} Poções", "none": "Sem Poção"},
+				"mana-potion": {"singular": "1 Mana", "plural": "{{.Count}} Manas", "none": "Sem Mana"}
+			}
+		}}`), 0o600))
+
+		var stdout, stderr bytes.Buffer
+		code := run(append(append([]string{"lint"}, defaults...), pt, items), &stdout, &stderr)
+		require.Equal(t, exitFail, code, stderr.String())
+		require.Equal(t, pt+": missing key \"hello_world2\"\n", stdout.String())
+	})
+
+	t.Run("custom forms", func(t *testing.T) {
+		dir := t.TempDir()
+		en := filepath.Join(dir, "en.json")
+		pt := filepath.Join(dir, "pt.json")
+		require.NoError(t, os.WriteFile(en, []byte(`{"armor": {"one": "1 Armor", "other": "{{.Count}} Armors", "zero": "No Armor"}}`), 0o600))
+		require.NoError(t, os.WriteFile(pt, []byte(`{"armor": {"one": "1 Armadura", "other": "{{.Count}} Armaduras"}}`), 0o600))
+
+		var stdout, stderr bytes.Buffer
+		code := run([]string{"lint", "-forms", "one,other,zero", "-default", en, pt}, &stdout, &stderr)
+		require.Equal(t, exitFail, code, stderr.String())
+		require.Equal(t, pt+": key \"armor\" is missing plural form \"zero\"\n", stdout.String())
+	})
+
+	t.Run("invalid forms", func(t *testing.T) {
+		var stdout, stderr bytes.Buffer
+		require.Equal(t, exitUsage, run(append([]string{"lint", "-forms", "one,one,zero"}, defaults...), &stdout, &stderr))
+		require.Contains(t, stderr.String(), "invalid plural forms")
+	})
+
+	t.Run("missing default", func(t *testing.T) {
+		var stdout, stderr bytes.Buffer
+		require.Equal(t, exitUsage, run([]string{"lint", "../../translations/pt_BR.json"}, &stdout, &stderr))
+	})
+
+	t.Run("invalid file", func(t *testing.T) {
+		var stdout, stderr bytes.Buffer
+		require.Equal(t, exitUsage, run(append(append([]string{"lint"}, defaults...), "invalid"), &stdout, &stderr))
+		require.Contains(t, stderr.String(), "invalid")
+	})
+}
@@ -0,0 +1,109 @@
+// Command gotr provides tooling for gotr translation catalogs.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/leoviggiano/gotr/internal/catalog"
+	"github.com/leoviggiano/gotr/internal/scanner"
+)
+
+const (
+	exitOK    = 0
+	exitFail  = 1
+	exitUsage = 2
+)
+
+type command func(args []string, stdout, stderr io.Writer) int
+
+var commands = map[string]command{
+	"export":       runExport,
+	"import":       runImport,
+	"extract":      runExtract,
+	"lint":         runLint,
+	"placeholders": runPlaceholders,
+	"pseudo":       runPseudo,
+	"stats":        runStats,
+}
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdout, os.Stderr))
+}
+
+func run(args []string, stdout, stderr io.Writer) int {
+	if len(args) == 0 {
+		usage(stderr)
+		return exitUsage
+	}
+
+	cmd, ok := commands[args[0]]
+	if !ok {
+		fmt.Fprintf(stderr, "gotr: unknown command %q\n", args[0])
+		usage(stderr)
+		return exitUsage
+	}
+
+	return cmd(args[1:], stdout, stderr)
+}
+
+func usage(w io.Writer) {
+	names := make([]string, 0, len(commands))
+	for name := range commands {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	fmt.Fprintf(w, "usage: gotr <command> [flags]\n\ncommands: %s\n", strings.Join(names, ", "))
+}
+
+// files collects the values of a repeatable flag.
+type files []string
+
+func (f *files) String() string {
+	return strings.Join(*f, ",")
+}
+
+func (f *files) Set(value string) error {
+	*f = append(*f, value)
+	return nil
+}
+
+// pluralForms holds the form names of a -forms flag.
+type pluralForms scanner.Forms
+
+func (f *pluralForms) String() string {
+	return scanner.Forms(*f).String()
+}
+
+func (f *pluralForms) Set(value string) error {
+	forms, err := scanner.ParseForms(value)
+	if err != nil {
+		return err
+	}
+
+	*f = pluralForms(forms)
+	return nil
+}
+
+// formsFlag defines the -forms flag of fs, naming the forms of the plural blocks of the catalogs.
+func formsFlag(fs *flag.FlagSet) *scanner.Forms {
+	forms := scanner.DefaultForms
+	fs.Var((*pluralForms)(&forms), "forms", "comma-separated singular, plural and none form names")
+	return &forms
+}
+
+// localeOf derives the locale of a catalog file from its name the way RegisterDir does, so
+// pt_BR.json and pt_BR_items.json are both pt_BR. A file whose name holds no locale is one of its
+// own, identified by its path.
+func localeOf(path string) string {
+	if identifier, ok := catalog.Identifier(catalog.FilePattern, path); ok {
+		return identifier
+	}
+
+	return path
+}
@@ -0,0 +1,22 @@
+package main
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRun(t *testing.T) {
+	t.Run("no command", func(t *testing.T) {
+		var stdout, stderr bytes.Buffer
+		require.Equal(t, exitUsage, run(nil, &stdout, &stderr))
+		require.Contains(t, stderr.String(), "usage")
+	})
+
+	t.Run("unknown command", func(t *testing.T) {
+		var stdout, stderr bytes.Buffer
+		require.Equal(t, exitUsage, run([]string{"invalid"}, &stdout, &stderr))
+		require.Contains(t, stderr.String(), "unknown command")
+	})
+}
@@ -0,0 +1,106 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+
+	"github.com/leoviggiano/gotr"
+	"github.com/leoviggiano/gotr/internal/scanner"
+)
+
+// defaultIdentifier is the identifier the default locale files are registered with.
+const defaultIdentifier = "default"
+
+func runPlaceholders(args []string, stdout, stderr io.Writer) int {
+	var defaults files
+
+	fs := flag.NewFlagSet("placeholders", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	fs.Var(&defaults, "default", "catalog file of the default locale (repeatable)")
+	asJSON := fs.Bool("json", false, "print issues as JSON")
+	forms := formsFlag(fs)
+	fs.Usage = func() {
+		fmt.Fprintln(stderr, "usage: gotr placeholders -default <file> [-default <file>...] [-json] <file>...")
+		fs.PrintDefaults()
+	}
+
+	if err := fs.Parse(args); err != nil {
+		return exitUsage
+	}
+
+	if len(defaults) == 0 {
+		fs.Usage()
+		return exitUsage
+	}
+
+	translator, err := newTranslator(defaults, fs.Args(), *forms)
+	if err != nil {
+		fmt.Fprintf(stderr, "gotr placeholders: %v\n", err)
+		return exitUsage
+	}
+
+	issues := translator.ValidatePlaceholders()
+
+	if *asJSON {
+		enc := json.NewEncoder(stdout)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(issues); err != nil {
+			fmt.Fprintf(stderr, "gotr placeholders: %v\n", err)
+			return exitUsage
+		}
+	} else {
+		for _, issue := range issues {
+			fmt.Fprintln(stdout, formatPlaceholderIssue(issue))
+		}
+	}
+
+	if len(issues) > 0 {
+		return exitFail
+	}
+
+	return exitOK
+}
+
+// newTranslator registers the default files under defaultIdentifier and every other file under its
+// locale, see localeOf, reading the plural blocks of forms.
+func newTranslator(defaults, paths []string, forms scanner.Forms) (gotr.Translator, error) {
+	translator, err := gotr.NewTranslator(
+		gotr.WithPluralForms(forms.Singular, forms.Plural, forms.None),
+		gotr.WithDefault(defaultIdentifier, defaults[0]),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", defaults[0], err)
+	}
+
+	for _, path := range defaults[1:] {
+		if err := translator.Register(defaultIdentifier, path); err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+	}
+
+	for _, path := range paths {
+		if err := translator.Register(localeOf(path), path); err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+	}
+
+	return translator, nil
+}
+
+func formatPlaceholderIssue(issue gotr.PlaceholderIssue) string {
+	key := fmt.Sprintf("%q", issue.Key)
+	if issue.Form != "" {
+		key = fmt.Sprintf("%q (%s)", issue.Key, issue.Form)
+	}
+
+	switch issue.Kind {
+	case gotr.PlaceholderMisspelled:
+		return fmt.Sprintf("%s: key %s has placeholder {{.%s}}, expected {{.%s}}", issue.Identifier, key, issue.Placeholder, issue.Expected)
+	case gotr.PlaceholderExtra:
+		return fmt.Sprintf("%s: key %s has extra placeholder {{.%s}}", issue.Identifier, key, issue.Placeholder)
+	default:
+		return fmt.Sprintf("%s: key %s is missing placeholder {{.%s}}", issue.Identifier, key, issue.Placeholder)
+	}
+}
@@ -0,0 +1,83 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/leoviggiano/gotr"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunPlaceholders(t *testing.T) {
+	defaults := []string{"-default", "../../translations/en_US.json", "-default", "../../translations/en_US_items.json"}
+
+	broken := filepath.Join(t.TempDir(), "pt.json")
+	err := os.WriteFile(broken, []byte(`{"items": {"equipments": {"armor": {
+		"singular": "{{.Name}} tem {{.Count}} Armadura.",
+		"plural": "{{.Nome}} tem {{.Count}} Armaduras.",
+		"none": "{{.Name}} não tem Armadura."
+	}}}}`), 0o600)
+	require.NoError(t, err)
+
+	t.Run("success", func(t *testing.T) {
+		var stdout, stderr bytes.Buffer
+		code := run(append(append([]string{"placeholders"}, defaults...), "../../translations/pt_BR.json"), &stdout, &stderr)
+		require.Equal(t, exitOK, code)
+		require.Empty(t, stdout.String())
+	})
+
+	t.Run("issues", func(t *testing.T) {
+		var stdout, stderr bytes.Buffer
+		code := run(append(append([]string{"placeholders"}, defaults...), broken), &stdout, &stderr)
+		require.Equal(t, exitFail, code)
+		require.Equal(t, "pt: key \"items.equipments.armor\" (plural) has placeholder {{.Nome}}, expected {{.Name}}\n", stdout.String())
+	})
+
+	t.Run("json output", func(t *testing.T) {
+		var stdout, stderr bytes.Buffer
+		code := run(append(append([]string{"placeholders", "-json"}, defaults...), broken), &stdout, &stderr)
+		require.Equal(t, exitFail, code)
+
+		var issues []gotr.PlaceholderIssue
+		require.NoError(t, json.Unmarshal(stdout.Bytes(), &issues))
+		require.Equal(t, []gotr.PlaceholderIssue{{
+			Identifier:  "pt",
+			Key:         "items.equipments.armor",
+			Form:        "plural",
+			Kind:        gotr.PlaceholderMisspelled,
+			Placeholder: "Nome",
+			Expected:    "Name",
+		}}, issues)
+	})
+
+	t.Run("split locale", func(t *testing.T) {
+		dir := t.TempDir()
+		pt := filepath.Join(dir, "pt.json")
+		items := filepath.Join(dir, "pt_items.json")
+		require.NoError(t, os.WriteFile(pt, []byte(`{"texts": {"welcome": "Bem-vindo, {{.Nome}}!"}}`), 0o600))
+		require.NoError(t, os.WriteFile(items, []byte(`{"items": {"equipments": {"armor": {
+			"singular": "{{.Name}} tem {{.Count}} Armadura.",
+			"plural": "{{.Name}} tem Armaduras.",
+			"none": "{{.Name}} não tem Armadura."
+		}}}}`), 0o600))
+
+		var stdout, stderr bytes.Buffer
+		code := run(append(append([]string{"placeholders"}, defaults...), pt, items), &stdout, &stderr)
+		require.Equal(t, exitFail, code, stderr.String())
+		require.Equal(t, "pt: key \"items.equipments.armor\" (plural) is missing placeholder {{.Count}}\n"+
+			"pt: key \"texts.welcome\" has extra placeholder {{.Nome}}\n", stdout.String())
+	})
+
+	t.Run("missing default", func(t *testing.T) {
+		var stdout, stderr bytes.Buffer
+		require.Equal(t, exitUsage, run([]string{"placeholders", broken}, &stdout, &stderr))
+	})
+
+	t.Run("invalid file", func(t *testing.T) {
+		var stdout, stderr bytes.Buffer
+		require.Equal(t, exitUsage, run(append(append([]string{"placeholders"}, defaults...), "invalid"), &stdout, &stderr))
+	})
+}
@@ -0,0 +1,61 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/leoviggiano/gotr/internal/catalog"
+	"github.com/leoviggiano/gotr/internal/pseudo"
+)
+
+func runPseudo(args []string, stdout, stderr io.Writer) int {
+	fs := flag.NewFlagSet("pseudo", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	output := fs.String("o", "", "output file (default stdout)")
+	forms := formsFlag(fs)
+	fs.Usage = func() {
+		fmt.Fprintln(stderr, "usage: gotr pseudo [-o <file>] <file>")
+		fs.PrintDefaults()
+	}
+
+	if err := fs.Parse(args); err != nil {
+		return exitUsage
+	}
+
+	if fs.NArg() != 1 {
+		fs.Usage()
+		return exitUsage
+	}
+
+	tree, err := catalog.Load(fs.Arg(0))
+	if err != nil {
+		fmt.Fprintf(stderr, "gotr pseudo: %s: %v\n", fs.Arg(0), err)
+		return exitUsage
+	}
+
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "    ")
+	if err := enc.Encode(pseudo.Tree(tree, *forms)); err != nil {
+		fmt.Fprintf(stderr, "gotr pseudo: %v\n", err)
+		return exitFail
+	}
+
+	if *output == "" {
+		_, err = stdout.Write(buf.Bytes())
+	} else {
+		err = os.WriteFile(*output, buf.Bytes(), 0o644)
+	}
+
+	if err != nil {
+		fmt.Fprintf(stderr, "gotr pseudo: %v\n", err)
+		return exitFail
+	}
+
+	return exitOK
+}
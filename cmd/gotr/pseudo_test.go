@@ -0,0 +1,46 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunPseudo(t *testing.T) {
+	t.Run("stdout", func(t *testing.T) {
+		var stdout, stderr bytes.Buffer
+		code := run([]string{"pseudo", "../../translations/en_US.json"}, &stdout, &stderr)
+		require.Equal(t, exitOK, code)
+
+		var tree map[string]any
+		require.NoError(t, json.Unmarshal(stdout.Bytes(), &tree))
+		require.Equal(t, "[Ĥéļļö Ŵöŕļð ~~~~]", tree["hello_world"])
+	})
+
+	t.Run("output file", func(t *testing.T) {
+		output := filepath.Join(t.TempDir(), "en_XA.json")
+
+		var stdout, stderr bytes.Buffer
+		code := run([]string{"pseudo", "-o", output, "../../translations/en_US.json"}, &stdout, &stderr)
+		require.Equal(t, exitOK, code)
+		require.Empty(t, stdout.String())
+
+		data, err := os.ReadFile(output)
+		require.NoError(t, err)
+		require.Contains(t, string(data), `"welcome": "[Ŵéļçöṁé ţö ṁý ĝåṁé! ~~~~~~]"`)
+	})
+
+	t.Run("missing file", func(t *testing.T) {
+		var stdout, stderr bytes.Buffer
+		require.Equal(t, exitUsage, run([]string{"pseudo"}, &stdout, &stderr))
+	})
+
+	t.Run("invalid file", func(t *testing.T) {
+		var stdout, stderr bytes.Buffer
+		require.Equal(t, exitUsage, run([]string{"pseudo", "invalid"}, &stdout, &stderr))
+	})
+}
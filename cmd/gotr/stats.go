@@ -0,0 +1,66 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+
+	"github.com/leoviggiano/gotr"
+)
+
+func runStats(args []string, stdout, stderr io.Writer) int {
+	var defaults files
+
+	fs := flag.NewFlagSet("stats", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	fs.Var(&defaults, "default", "catalog file of the default locale (repeatable)")
+	asJSON := fs.Bool("json", false, "print the stats as JSON")
+	forms := formsFlag(fs)
+	fs.Usage = func() {
+		fmt.Fprintln(stderr, "usage: gotr stats -default <file> [-default <file>...] [-json] <file>...")
+		fs.PrintDefaults()
+	}
+
+	if err := fs.Parse(args); err != nil {
+		return exitUsage
+	}
+
+	if len(defaults) == 0 {
+		fs.Usage()
+		return exitUsage
+	}
+
+	translator, err := newTranslator(defaults, fs.Args(), *forms)
+	if err != nil {
+		fmt.Fprintf(stderr, "gotr stats: %v\n", err)
+		return exitUsage
+	}
+
+	stats := translator.Stats()
+
+	if *asJSON {
+		enc := json.NewEncoder(stdout)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(stats); err != nil {
+			fmt.Fprintf(stderr, "gotr stats: %v\n", err)
+			return exitFail
+		}
+
+		return exitOK
+	}
+
+	for _, s := range stats {
+		fmt.Fprintln(stdout, formatStats(s))
+	}
+
+	return exitOK
+}
+
+func formatStats(s gotr.LocaleStats) string {
+	return fmt.Sprintf("%s: %d/%d translated (%.1f%%), %d missing (%.1f%%), %d identical (%.1f%%), %d missing plural forms (%.1f%%)",
+		s.Identifier, s.Translated, s.Total, s.TranslatedPercent,
+		s.Missing, s.MissingPercent,
+		s.Identical, s.IdenticalPercent,
+		s.MissingPluralForms, s.MissingPluralFormsPercent)
+}
@@ -0,0 +1,63 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/leoviggiano/gotr"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunStats(t *testing.T) {
+	defaults := []string{"-default", "../../translations/en_US.json", "-default", "../../translations/en_US_items.json"}
+
+	t.Run("text output", func(t *testing.T) {
+		var stdout, stderr bytes.Buffer
+		code := run(append(append([]string{"stats"}, defaults...), "../../translations/pt_BR.json"), &stdout, &stderr)
+		require.Equal(t, exitOK, code, stderr.String())
+		require.Equal(t, "pt_BR: 6/7 translated (85.7%), 1 missing (14.3%), 0 identical (0.0%), 0 missing plural forms (0.0%)\n", stdout.String())
+	})
+
+	t.Run("json output", func(t *testing.T) {
+		var stdout, stderr bytes.Buffer
+		code := run(append(append([]string{"stats", "-json"}, defaults...), "../../translations/pt_BR.json"), &stdout, &stderr)
+		require.Equal(t, exitOK, code, stderr.String())
+
+		var stats []gotr.LocaleStats
+		require.NoError(t, json.Unmarshal(stdout.Bytes(), &stats))
+		require.Len(t, stats, 1)
+		require.Equal(t, 7, stats[0].Total)
+		require.Equal(t, 85.7, stats[0].TranslatedPercent)
+		require.Contains(t, stdout.String(), `"missingPluralFormsPercent": 0`)
+	})
+
+	t.Run("split locale", func(t *testing.T) {
+		dir := t.TempDir()
+		pt := filepath.Join(dir, "pt_BR.json")
+		items := filepath.Join(dir, "pt_BR_items.json")
+		require.NoError(t, os.WriteFile(pt, []byte(`{"hello_world": "Olá Mundo", "texts": {"welcome": "Bem-vindo!"}}`), 0o600))
+		require.NoError(t, os.WriteFile(items, []byte(`{"items": {"equipments": {"armor": {
+			"singular": "{{.Name}} tem {{.Count}} Armadura.",
+			"plural": "{{.Name}} tem {{.Count}} Armaduras.",
+			"none": "{{.Name}} não tem Armadura."
+		}}}}`), 0o600))
+
+		var stdout, stderr bytes.Buffer
+		code := run(append(append([]string{"stats"}, defaults...), pt, items), &stdout, &stderr)
+		require.Equal(t, exitOK, code, stderr.String())
+		require.Equal(t, "pt_BR: 3/7 translated (42.9%), 4 missing (57.1%), 0 identical (0.0%), 0 missing plural forms (0.0%)\n", stdout.String())
+	})
+
+	t.Run("missing default", func(t *testing.T) {
+		var stdout, stderr bytes.Buffer
+		require.Equal(t, exitUsage, run([]string{"stats", "../../translations/pt_BR.json"}, &stdout, &stderr))
+	})
+
+	t.Run("invalid file", func(t *testing.T) {
+		var stdout, stderr bytes.Buffer
+		require.Equal(t, exitUsage, run(append(append([]string{"stats"}, defaults...), "missing.json"), &stdout, &stderr))
+	})
+}
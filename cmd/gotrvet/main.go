@@ -0,0 +1,49 @@
+// Command gotrvet runs the gotrkey analyzer, checking gotr.Args literals against a translation catalog.
+//
+//	gotrvet -catalog translations/en_US.json,translations/en_US_items.json ./...
+//
+// On Go toolchains newer than its golang.org/x/tools, run it through go vet, with absolute catalog paths:
+//
+//	go vet -vettool=$(which gotrvet) -catalog=$PWD/translations/en_US.json ./...
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"log"
+	"os"
+
+	"github.com/leoviggiano/gotr/analysis/gotrkey"
+	"golang.org/x/tools/go/analysis/singlechecker"
+)
+
+// vetUsage tells how to run gotrvet when it can't load the packages itself.
+const vetUsage = `On Go toolchains newer than its golang.org/x/tools, gotrvet can't load packages
+("package ... without types"). Run it through go vet instead, with absolute catalog paths:
+
+	go vet -vettool=$(which gotrvet) -catalog=$PWD/translations/en_US.json ./...`
+
+func main() {
+	gotrkey.Analyzer.Doc += "\n\n" + vetUsage
+	log.SetOutput(hintWriter{w: os.Stderr})
+	singlechecker.Main(gotrkey.Analyzer)
+}
+
+// hintWriter writes the log to w, adding vetUsage to the error of packages loaded without types,
+// which the checker logs right before exiting.
+type hintWriter struct {
+	w io.Writer
+}
+
+func (h hintWriter) Write(p []byte) (int, error) {
+	if !bytes.Contains(p, []byte("without types")) {
+		return h.w.Write(p)
+	}
+
+	if _, err := fmt.Fprintf(h.w, "%s\n%s\n", bytes.TrimSuffix(p, []byte("\n")), vetUsage); err != nil {
+		return 0, err
+	}
+
+	return len(p), nil
+}
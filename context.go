@@ -0,0 +1,100 @@
+package gotr
+
+import (
+	"fmt"
+	"sort"
+)
+
+// contextSeparator separates the context from the text of a text-as-key entry, as msgctxt does in
+// gettext catalogs.
+const contextSeparator = "\x04"
+
+// Collision is a text of the default locale shared by the forms of several key paths in the same
+// message context, so looking it up by text only finds the last of them in sort order, whatever the
+// order they were registered in. Giving the messages a "msgctxt" tells them apart.
+type Collision struct {
+	Identifier string   `json:"identifier"`
+	Context    string   `json:"context,omitempty"` // Message context of the text, its "msgctxt"
+	Text       string   `json:"text"`
+	Keys       []string `json:"keys"` // Sorted key paths sharing the text
+}
+
+func (c Collision) String() string {
+	if c.Context == "" {
+		return fmt.Sprintf("%s: %q is the text of %v", c.Identifier, c.Text, c.Keys)
+	}
+
+	return fmt.Sprintf("%s: %q in context %q is the text of %v", c.Identifier, c.Text, c.Context, c.Keys)
+}
+
+// Collisions returns the texts of the default locale shared by several keys in the same message
+// context, as found by the registrations so far.
+func (t *translator) Collisions() []Collision {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	return append([]Collision{}, t.collisions...)
+}
+
+// findCollisions groups the key paths of the default locale by the texts of their forms and their
+// context and returns the groups of more than one key.
+func (t *translator) findCollisions() []Collision {
+	groups := make(map[string]*Collision)
+	for key, tpl := range t.templates[t.defaultIdentifier] {
+		if tpl.path != key {
+			continue
+		}
+
+		for _, text := range tpl.texts() {
+			alias := t.textKey(tpl.metadata.MessageContext, text)
+			if groups[alias] == nil {
+				groups[alias] = &Collision{Identifier: t.defaultIdentifier, Context: tpl.metadata.MessageContext, Text: t.textKey("", text)}
+			}
+
+			// Forms of a template may share a text.
+			if keys := groups[alias].Keys; len(keys) > 0 && keys[len(keys)-1] == key {
+				continue
+			}
+
+			groups[alias].Keys = append(groups[alias].Keys, key)
+		}
+	}
+
+	var collisions []Collision
+	for _, c := range groups {
+		if len(c.Keys) > 1 {
+			sort.Strings(c.Keys)
+			collisions = append(collisions, *c)
+		}
+	}
+
+	sort.Slice(collisions, func(i, j int) bool {
+		if collisions[i].Text != collisions[j].Text {
+			return collisions[i].Text < collisions[j].Text
+		}
+
+		return collisions[i].Context < collisions[j].Context
+	})
+
+	return collisions
+}
+
+// lookup finds the template of args in identifier: a text is looked up in args.Context first, and
+// then as a key path or a text without context.
+func (t *translator) lookup(identifier string, args Args) (template, bool) {
+	if args.Context != "" {
+		if tpl, ok := t.find(identifier, t.textKey(args.Context, args.Localizer)); ok {
+			return tpl, true
+		}
+	}
+
+	if tpl, ok := t.find(identifier, args.Localizer); ok {
+		return tpl, true
+	}
+
+	if key := t.textKey("", args.Localizer); key != args.Localizer {
+		return t.find(identifier, key)
+	}
+
+	return template{}, false
+}
@@ -0,0 +1,123 @@
+package gotr
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTranslator_GetContext(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, dir, "en.json", `{
+		"actions": {"open": {"singular": "Open", "msgctxt": "verb"}},
+		"states": {"open": {"singular": "Open", "msgctxt": "state"}},
+		"close": "Close",
+		"save": {"singular": "Save", "msgctxt": "verb", "context": "Shown on the settings page"},
+		"edit": {"singular": "Edit", "context": "Shown on the settings page"}
+	}`)
+	writeFile(t, dir, "pt.json", `{
+		"actions": {"open": "Abrir"},
+		"states": {"open": "Aberto"},
+		"close": "Fechar",
+		"save": "Salvar",
+		"edit": "Editar"
+	}`)
+
+	translator, err := NewTranslator(WithDefault("en", filepath.Join(dir, "en.json")))
+	require.NoError(t, err)
+	require.NoError(t, translator.Register("pt", filepath.Join(dir, "pt.json")))
+
+	tt := []struct {
+		name     string
+		args     Args
+		expected string
+	}{
+		{name: "verb", args: Args{Identifier: "pt", Localizer: "Open", Context: "verb"}, expected: "Abrir"},
+		{name: "state", args: Args{Identifier: "pt", Localizer: "Open", Context: "state"}, expected: "Aberto"},
+		{name: "default locale", args: Args{Identifier: "en", Localizer: "Open", Context: "state"}, expected: "Open"},
+		{name: "unknown context", args: Args{Identifier: "pt", Localizer: "Open", Context: "noun"}, expected: "Open"},
+		{name: "without context", args: Args{Identifier: "pt", Localizer: "Open"}, expected: "Open"},
+		{name: "text without context", args: Args{Identifier: "pt", Localizer: "Close", Context: "verb"}, expected: "Fechar"},
+		{name: "unique text without context", args: Args{Identifier: "pt", Localizer: "Save"}, expected: "Salvar"},
+		{name: "unique text in context", args: Args{Identifier: "pt", Localizer: "Save", Context: "verb"}, expected: "Salvar"},
+		{name: "note for translators", args: Args{Identifier: "pt", Localizer: "Edit"}, expected: "Editar"},
+		{name: "key path", args: Args{Identifier: "pt", Localizer: "states.open", Context: "verb"}, expected: "Aberto"},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, translator.Get(tc.args))
+		})
+	}
+
+	require.Empty(t, translator.Collisions())
+}
+
+func TestTranslator_ContextPO(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, dir, "en.po", "msgctxt \"verb\"\nmsgid \"Open\"\nmsgstr \"Open\"\n\nmsgctxt \"state\"\nmsgid \"Open\"\nmsgstr \"Open\"\n")
+	writeFile(t, dir, "pt.po", "msgctxt \"verb\"\nmsgid \"Open\"\nmsgstr \"Abrir\"\n\nmsgctxt \"state\"\nmsgid \"Open\"\nmsgstr \"Aberto\"\n")
+
+	translator, err := NewTranslator(WithDefault("en", filepath.Join(dir, "en.po")))
+	require.NoError(t, err)
+	require.NoError(t, translator.Register("pt", filepath.Join(dir, "pt.po")))
+
+	require.Empty(t, translator.Collisions())
+	require.Equal(t, "Abrir", translator.Get(Args{Identifier: "pt", Localizer: "Open", Context: "verb"}))
+	require.Equal(t, "Aberto", translator.Get(Args{Identifier: "pt", Localizer: "Open", Context: "state"}))
+}
+
+func TestTranslator_Collisions(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, dir, "en.json", `{
+		"actions": {"open": "Open", "close": {"singular": "Close", "msgctxt": "verb"}},
+		"states": {"open": "Open", "closed": "Closed"},
+		"doors": {"close": {"singular": "Close", "msgctxt": "verb"}}
+	}`)
+	writeFile(t, dir, "pt.json", `{"actions": {"open": "Abrir"}, "states": {"open": "Aberto"}}`)
+
+	translator, err := NewTranslator(WithDefault("en", filepath.Join(dir, "en.json")))
+	require.NoError(t, err)
+	require.NoError(t, translator.Register("pt", filepath.Join(dir, "pt.json")))
+
+	collisions := []Collision{
+		{Identifier: "en", Context: "verb", Text: "Close", Keys: []string{"actions.close", "doors.close"}},
+		{Identifier: "en", Text: "Open", Keys: []string{"actions.open", "states.open"}},
+	}
+	require.Equal(t, collisions, translator.Collisions())
+	require.Equal(t, `en: "Close" in context "verb" is the text of [actions.close doors.close]`, collisions[0].String())
+	require.Equal(t, `en: "Open" is the text of [actions.open states.open]`, collisions[1].String())
+
+	// The last key with the text in sort order wins.
+	require.Equal(t, "Aberto", translator.Get(Args{Identifier: "pt", Localizer: "Open"}))
+
+	t.Run("registration order", func(t *testing.T) {
+		writeFile(t, dir, "en_zeta.json", `{"zeta": "Shared"}`)
+		writeFile(t, dir, "en_alpha.json", `{"alpha": "Shared"}`)
+		writeFile(t, dir, "pt_split.json", `{"alpha": "Alfa", "zeta": "Zeta"}`)
+
+		translator, err := NewTranslator(WithDefault("en", filepath.Join(dir, "en_zeta.json")))
+		require.NoError(t, err)
+		require.NoError(t, translator.Register("en", filepath.Join(dir, "en_alpha.json")))
+		require.NoError(t, translator.Register("pt", filepath.Join(dir, "pt_split.json")))
+
+		require.Equal(t, []Collision{{Identifier: "en", Text: "Shared", Keys: []string{"alpha", "zeta"}}}, translator.Collisions())
+		require.Equal(t, "Zeta", translator.Get(Args{Identifier: "pt", Localizer: "Shared"}))
+	})
+
+	t.Run("resolved", func(t *testing.T) {
+		err := translator.RegisterYAML("en", strings.NewReader("states:\n  open:\n    singular: Open\n    msgctxt: state\n"))
+		require.NoError(t, err)
+
+		require.Equal(t, collisions[:1], translator.Collisions())
+		require.Equal(t, "Abrir", translator.Get(Args{Identifier: "pt", Localizer: "Open"}))
+		require.Equal(t, "Aberto", translator.Get(Args{Identifier: "pt", Localizer: "Open", Context: "state"}))
+	})
+
+	t.Run("unregistered", func(t *testing.T) {
+		translator.Unregister("en")
+		require.Empty(t, translator.Collisions())
+	})
+}
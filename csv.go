@@ -0,0 +1,198 @@
+package gotr
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/leoviggiano/gotr/internal/catalog"
+)
+
+// ExportCSV writes every registered locale, except the pseudo locale, as a CSV sheet with one column
+// per identifier, the default first, and one row per key path. Plural messages get one row per form,
+// keyed "path.form" with the form names of WithPluralForms, so the sheet can be imported back with
+// ImportCSV.
+func (t *translator) ExportCSV(w io.Writer) error {
+	return catalog.EncodeCSV(w, t.sheet(), ',')
+}
+
+// ExportTSV writes the sheet of ExportCSV with tab separated fields, to be imported with ImportTSV.
+func (t *translator) ExportTSV(w io.Writer) error {
+	return catalog.EncodeCSV(w, t.sheet(), '\t')
+}
+
+func (t *translator) sheet() catalog.Sheet {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	identifiers := t.identifiers()
+
+	plural := make(map[string]bool)
+	for _, identifier := range identifiers {
+		for key, tpl := range t.templates[identifier] {
+			if tpl.path == key {
+				plural[key] = plural[key] || !tpl.plain()
+			}
+		}
+	}
+
+	keys := make([]string, 0, len(plural))
+	for key := range plural {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	sheet := catalog.Sheet{Locales: identifiers}
+	for _, key := range keys {
+		if !plural[key] {
+			row := catalog.SheetRow{Path: key}
+			for _, identifier := range identifiers {
+				row.Texts = append(row.Texts, t.templates[identifier][key].Singular)
+			}
+
+			sheet.Rows = append(sheet.Rows, row)
+			continue
+		}
+
+		for _, form := range catalog.PluralForms {
+			row := catalog.SheetRow{Path: key + "." + t.pluralForms().Name(form)}
+			for _, identifier := range identifiers {
+				row.Texts = append(row.Texts, t.templates[identifier][key].forms().Get(form))
+			}
+
+			sheet.Rows = append(sheet.Rows, row)
+		}
+	}
+
+	return sheet
+}
+
+// identifiers returns the registered identifiers, the default first and the others sorted, leaving
+// out the generated pseudo locale.
+func (t *translator) identifiers() []string {
+	var identifiers []string
+	for identifier := range t.templates {
+		if identifier != t.defaultIdentifier && identifier != t.pseudoIdentifier {
+			identifiers = append(identifiers, identifier)
+		}
+	}
+	sort.Strings(identifiers)
+
+	if _, ok := t.templates[t.defaultIdentifier]; ok {
+		identifiers = append([]string{t.defaultIdentifier}, identifiers...)
+	}
+
+	return identifiers
+}
+
+// ImportCSV merges the texts of a sheet written by ExportCSV into the JSON catalogs of each column's
+// identifier in dir. A locale split in several files, like en_US.json and en_US_items.json, gets
+// every key written back to the file holding the longest part of its path, and keys none of them
+// holds go to the catalog named after the identifier. Rows keyed "path.form" are nested like the
+// catalogs Register reads, existing catalogs keep the order of their keys and empty cells are
+// skipped. Identifiers holding path separators are rejected. It returns the paths of the catalogs
+// written.
+func ImportCSV(r io.Reader, dir string) ([]string, error) {
+	return importSheet(r, dir, ',')
+}
+
+// ImportTSV merges a sheet written by ExportTSV, like ImportCSV.
+func ImportTSV(r io.Reader, dir string) ([]string, error) {
+	return importSheet(r, dir, '\t')
+}
+
+func importSheet(r io.Reader, dir string, comma rune) ([]string, error) {
+	sheet, err := catalog.DecodeCSV(r, comma)
+	if err != nil {
+		return nil, err
+	}
+
+	var paths []string
+	for i, identifier := range sheet.Locales {
+		files, err := localeFiles(dir, identifier)
+		if err != nil {
+			return nil, err
+		}
+
+		objects := make([]*catalog.Object, len(files))
+		for j, path := range files {
+			objects[j], err = catalog.LoadObject(path)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		// The catalog named after the identifier is always written, as the one new keys go to.
+		changed := map[int]bool{0: true}
+		for _, row := range sheet.Rows {
+			if row.Texts[i] == "" {
+				continue
+			}
+
+			j := deepest(objects, row.Path)
+			objects[j].Set(row.Path, row.Texts[i])
+			changed[j] = true
+		}
+
+		for j, path := range files {
+			if !changed[j] {
+				continue
+			}
+
+			var buf bytes.Buffer
+			if err := objects[j].Encode(&buf); err != nil {
+				return nil, err
+			}
+
+			if err := os.WriteFile(path, buf.Bytes(), 0o644); err != nil {
+				return nil, err
+			}
+
+			paths = append(paths, path)
+		}
+	}
+
+	return paths, nil
+}
+
+// localeFiles returns the JSON catalogs of identifier in dir, the one named after it first and then
+// the others whose names start with it, as RegisterDir groups them.
+func localeFiles(dir, identifier string) ([]string, error) {
+	files := []string{filepath.Join(dir, identifier+".json")}
+
+	entries, err := os.ReadDir(dir)
+	if os.IsNotExist(err) {
+		return files, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || filepath.Ext(name) != ".json" || name == identifier+".json" {
+			continue
+		}
+
+		if id, ok := catalog.Identifier(catalog.FilePattern, name); ok && id == identifier {
+			files = append(files, filepath.Join(dir, name))
+		}
+	}
+
+	return files, nil
+}
+
+// deepest returns the index of the object holding the longest part of path, the first on ties.
+func deepest(objects []*catalog.Object, path string) int {
+	best, depth := 0, objects[0].Depth(path)
+	for i, obj := range objects[1:] {
+		if d := obj.Depth(path); d > depth {
+			best, depth = i+1, d
+		}
+	}
+
+	return best
+}
@@ -0,0 +1,131 @@
+package gotr
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTranslator_ExportCSV(t *testing.T) {
+	translator, err := NewTranslator(
+		WithDefault("en", "./translations/en_US.json"),
+		WithDefault("en", "./translations/en_US_items.json"),
+		WithPseudoLocale("en-XA"),
+	)
+	require.NoError(t, err)
+
+	err = translator.Register("pt", "./translations/pt_BR.json")
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	err = translator.ExportCSV(&buf)
+	require.NoError(t, err)
+
+	csv := buf.String()
+	require.True(t, strings.HasPrefix(csv, "key,en,pt\n"), csv)
+	require.Contains(t, csv, "\nhello_world,Hello World,Olá Mundo\n")
+	require.Contains(t, csv, "\nhello_world2,Hello World 2,\n")
+	require.Contains(t, csv, "\nitems.equipments.armor.singular,{{.Name}} has {{.Count}} Armor.,{{.Name}} tem {{.Count}} Armadura.\n"+
+		"items.equipments.armor.plural,{{.Name}} has {{.Count}} Armors.,{{.Name}} tem {{.Count}} Armaduras.\n"+
+		"items.equipments.armor.none,{{.Name}} has no Armor.,{{.Name}} não tem Armadura.\n")
+
+	t.Run("round trip", func(t *testing.T) {
+		dir := t.TempDir()
+		err := os.WriteFile(filepath.Join(dir, "pt.json"), []byte("{\n    \"texts\": {\n        \"welcome\": \"Bem-vindo!\"\n    }\n}\n"), 0o600)
+		require.NoError(t, err)
+
+		edited := strings.Replace(csv, "hello_world2,Hello World 2,", "hello_world2,Hello World 2,Olá Mundo 2", 1)
+		paths, err := ImportCSV(strings.NewReader(edited), dir)
+		require.NoError(t, err)
+		require.Equal(t, []string{filepath.Join(dir, "en.json"), filepath.Join(dir, "pt.json")}, paths)
+
+		data, err := os.ReadFile(filepath.Join(dir, "pt.json"))
+		require.NoError(t, err)
+		require.True(t, strings.HasPrefix(string(data), "{\n    \"texts\": {\n        \"welcome\": \"Bem-vindo ao meu jogo!\",\n"), string(data))
+
+		imported, err := NewTranslator(WithDefault("en", filepath.Join(dir, "en.json")))
+		require.NoError(t, err)
+
+		err = imported.Register("pt", filepath.Join(dir, "pt.json"))
+		require.NoError(t, err)
+
+		// The keys of both default files are imported into en.json.
+		require.Equal(t, "Hello World", imported.Get(Args{Localizer: "hello_world"}))
+		require.Equal(t, "John has no Armor.", imported.Get(Args{Localizer: "items.equipments.armor", Args: map[string]any{"Name": "John"}}))
+		require.Equal(t, "Olá Mundo 2", imported.Get(Args{Identifier: "pt", Localizer: "hello_world2"}))
+		for _, count := range []int{0, 1, 2} {
+			args := Args{Identifier: "pt", Localizer: "items.equipments.armor", Args: map[string]any{"Name": "John", "Count": count}, Count: count}
+			require.Equal(t, translator.Get(args), imported.Get(args))
+		}
+	})
+
+	t.Run("tsv", func(t *testing.T) {
+		var buf bytes.Buffer
+		err := translator.ExportTSV(&buf)
+		require.NoError(t, err)
+		require.Contains(t, buf.String(), "\nhello_world\tHello World\tOlá Mundo\n")
+
+		dir := t.TempDir()
+		paths, err := ImportTSV(&buf, dir)
+		require.NoError(t, err)
+		require.Equal(t, []string{filepath.Join(dir, "en.json"), filepath.Join(dir, "pt.json")}, paths)
+
+		imported, err := NewTranslator(WithDefault("en", filepath.Join(dir, "en.json")))
+		require.NoError(t, err)
+		require.Equal(t, "Hello World", imported.Get(Args{Localizer: "hello_world"}))
+	})
+
+	t.Run("split locale", func(t *testing.T) {
+		dir := t.TempDir()
+		for name, source := range map[string]string{"en.json": "en_US.json", "en_items.json": "en_US_items.json"} {
+			data, err := os.ReadFile(filepath.Join("translations", source))
+			require.NoError(t, err)
+			writeFile(t, dir, name, string(data))
+		}
+
+		edited := strings.Replace(csv, "items.equipments.armor.none,{{.Name}} has no Armor.", "items.equipments.armor.none,{{.Name}} has no armor.", 1)
+		paths, err := ImportCSV(strings.NewReader(edited), dir)
+		require.NoError(t, err)
+		require.Equal(t, []string{filepath.Join(dir, "en.json"), filepath.Join(dir, "en_items.json"), filepath.Join(dir, "pt.json")}, paths)
+
+		data, err := os.ReadFile(filepath.Join(dir, "en.json"))
+		require.NoError(t, err)
+		require.NotContains(t, string(data), "items")
+
+		// Every key went back to its own file, so merging them finds no duplicates.
+		imported, err := NewTranslator(WithDefaultIdentifier("en"), WithMergePolicy(MergeError), WithDir(dir))
+		require.NoError(t, err)
+		require.Empty(t, imported.Conflicts())
+		require.Equal(t, "John has no armor.", imported.Get(Args{Localizer: "items.equipments.armor", Args: map[string]any{"Name": "John"}}))
+	})
+
+	t.Run("message objects keep their metadata", func(t *testing.T) {
+		dir := t.TempDir()
+		writeFile(t, dir, "pt.json", `{"open": {"singular": "Abrir", "msgctxt": "verb"}}`)
+
+		_, err := ImportCSV(strings.NewReader("key,pt\nopen,Abrir\n"), dir)
+		require.NoError(t, err)
+
+		data, err := os.ReadFile(filepath.Join(dir, "pt.json"))
+		require.NoError(t, err)
+		require.Equal(t, "{\n    \"open\": {\n        \"singular\": \"Abrir\",\n        \"msgctxt\": \"verb\"\n    }\n}\n", string(data))
+	})
+
+	t.Run("invalid sheet", func(t *testing.T) {
+		paths, err := ImportCSV(strings.NewReader("path,en\n"), t.TempDir())
+		require.Error(t, err)
+		require.Nil(t, paths)
+	})
+
+	t.Run("identifier with a path separator", func(t *testing.T) {
+		dir := t.TempDir()
+		paths, err := ImportCSV(strings.NewReader("key,../en\nhello_world,Hello\n"), filepath.Join(dir, "sheets"))
+		require.Error(t, err)
+		require.Nil(t, paths)
+		require.NoFileExists(t, filepath.Join(dir, "en.json"))
+	})
+}
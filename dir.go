@@ -0,0 +1,126 @@
+package gotr
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+
+	"github.com/leoviggiano/gotr/internal/catalog"
+)
+
+var (
+	errNoTranslationFiles   = errors.New("no translation files found")
+	errDefaultNotRegistered = errors.New("default identifier has no translation files")
+	errInvalidFilePattern   = errors.New("file pattern must capture the identifier")
+)
+
+// WithDefaultIdentifier sets the identifier of the default locale without registering a file, for
+// the files of a directory to provide it. It must come before WithDir.
+func WithDefaultIdentifier(identifier string) option {
+	return func(t *translator) error {
+		if t.defaultIdentifier != "" && identifier != t.defaultIdentifier {
+			return errDefaultAlreadyRegistered
+		}
+
+		if t.pseudoIdentifier != "" && identifier == t.pseudoIdentifier {
+			return errPseudoLocaleIsDefault
+		}
+
+		t.defaultIdentifier = identifier
+		t.reindex()
+		return nil
+	}
+}
+
+// WithFilePattern sets the pattern RegisterDir derives identifiers from. It is matched against the
+// file name without its extension, and the identifier is its "identifier" group, or its first group.
+// It must come before WithDir.
+func WithFilePattern(pattern *regexp.Regexp) option {
+	return func(t *translator) error {
+		if pattern.NumSubexp() == 0 {
+			return errInvalidFilePattern
+		}
+
+		t.filePattern = pattern
+		return nil
+	}
+}
+
+// WithDir registers the translation files of dir, see RegisterDir.
+func WithDir(dir string) option {
+	return func(t *translator) error {
+		return t.RegisterDir(dir)
+	}
+}
+
+// RegisterDir registers every translation file of dir whose name matches the file pattern under the
+// identifier it captures. Files sharing an identifier, like en_US.json and en_US_items.json, are
+// merged into the same locale, and the files of the default identifier are registered first.
+func (t *translator) RegisterDir(dir string, options ...registerOption) error {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return err
+	}
+
+	paths := make(map[string][]string)
+	for _, entry := range entries {
+		if entry.IsDir() || !catalog.IsCatalog(entry.Name()) {
+			continue
+		}
+
+		identifier, ok := t.identifierOf(entry.Name())
+		if !ok {
+			continue
+		}
+
+		paths[identifier] = append(paths[identifier], filepath.Join(dir, entry.Name()))
+	}
+
+	if len(paths) == 0 {
+		return fmt.Errorf("%w: %s", errNoTranslationFiles, dir)
+	}
+
+	identifiers := make([]string, 0, len(paths))
+	for identifier := range paths {
+		if identifier != t.defaultIdentifier {
+			identifiers = append(identifiers, identifier)
+		}
+	}
+	sort.Strings(identifiers)
+
+	if _, ok := paths[t.defaultIdentifier]; ok {
+		identifiers = append([]string{t.defaultIdentifier}, identifiers...)
+	}
+
+	for _, identifier := range identifiers {
+		for _, path := range paths[identifier] {
+			if err := t.Register(identifier, path, options...); err != nil {
+				return fmt.Errorf("%s: %w", path, err)
+			}
+		}
+	}
+
+	t.mu.RLock()
+	_, ok := t.templates[t.defaultIdentifier]
+	t.mu.RUnlock()
+
+	if t.defaultIdentifier != "" && !ok {
+		return fmt.Errorf("%w: %s", errDefaultNotRegistered, t.defaultIdentifier)
+	}
+
+	return nil
+}
+
+// identifierOf derives the identifier of a translation file from its name, with catalog.FilePattern
+// unless another pattern is set.
+func (t *translator) identifierOf(name string) (string, bool) {
+	pattern := t.filePattern
+	if pattern == nil {
+		pattern = catalog.FilePattern
+	}
+
+	return catalog.Identifier(pattern, name)
+}
@@ -0,0 +1,174 @@
+package gotr
+
+import (
+	"os"
+	"path/filepath"
+	"regexp"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithDir(t *testing.T) {
+	t.Run("translations", func(t *testing.T) {
+		translator, err := NewTranslator(
+			WithDefaultIdentifier("en_US"),
+			WithDir("./translations"),
+		)
+		require.NoError(t, err)
+
+		args := Args{Localizer: "{{.Name}} has {{.Count}} Armor.", Args: map[string]any{"Name": "John", "Count": 10}, Count: 10}
+
+		args.Identifier = "en_US"
+		require.Equal(t, "John has 10 Armors.", translator.Get(args))
+
+		args.Identifier = "pt_BR"
+		require.Equal(t, "John tem 10 Armaduras.", translator.Get(args))
+
+		require.Equal(t, "Olá Mundo", translator.Get(Args{Identifier: "pt_BR", Localizer: "hello_world"}))
+	})
+
+	t.Run("default registered first", func(t *testing.T) {
+		dir := t.TempDir()
+		writeFile(t, dir, "de.json", `{"hello_world": "Hallo Welt"}`)
+		writeFile(t, dir, "fr.yaml", `hello_world: Bonjour le monde`)
+		writeFile(t, dir, "fr_extra.json", `{"goodbye": "Au revoir"}`)
+		writeFile(t, dir, "pt_BR.po", "msgid \"\"\nmsgstr \"X-Generator: gotr\\n\"\n\nmsgctxt \"hello_world\"\nmsgid \"Hello World\"\nmsgstr \"Olá Mundo\"\n")
+		writeFile(t, dir, "README.md", "not a catalog")
+		writeFile(t, dir, "notes.json", `{}`)
+		require.NoError(t, os.Mkdir(filepath.Join(dir, "en.json"), 0o755))
+
+		translator, err := NewTranslator(WithDefaultIdentifier("fr"), WithDir(dir))
+		require.NoError(t, err)
+
+		require.Equal(t, "Hallo Welt", translator.Get(Args{Identifier: "de", Localizer: "Bonjour le monde"}))
+		require.Equal(t, "Au revoir", translator.Get(Args{Identifier: "fr", Localizer: "goodbye"}))
+		require.Equal(t, "Olá Mundo", translator.Get(Args{Identifier: "pt_BR", Localizer: "hello_world"}))
+	})
+
+	t.Run("file pattern", func(t *testing.T) {
+		dir := t.TempDir()
+		writeFile(t, dir, "messages_en.properties", "hello_world = Hello World")
+		writeFile(t, dir, "messages_pt_BR.properties", "hello_world = Ol\\u00e1 Mundo")
+		writeFile(t, dir, "en_US.json", `{"hello_world": "Hi"}`)
+
+		translator, err := NewTranslator(
+			WithDefaultIdentifier("en"),
+			WithFilePattern(regexp.MustCompile(`^messages_(?P<identifier>.+)$`)),
+			WithDir(dir),
+		)
+		require.NoError(t, err)
+
+		require.Equal(t, "Olá Mundo", translator.Get(Args{Identifier: "pt_BR", Localizer: "Hello World"}))
+		require.Equal(t, "Hello World", translator.Get(Args{Identifier: "en_US", Localizer: "hello_world"}))
+	})
+
+	ttErrors := []struct {
+		name    string
+		options func(dir string) []option
+		err     error
+	}{
+		{
+			name:    "no translation files",
+			options: func(dir string) []option { return []option{WithDir(dir)} },
+			err:     errNoTranslationFiles,
+		},
+		{
+			name:    "missing default",
+			options: func(string) []option { return []option{WithDefaultIdentifier("es"), WithDir("./translations")} },
+			err:     errDefaultNotRegistered,
+		},
+		{
+			name:    "pattern without groups",
+			options: func(string) []option { return []option{WithFilePattern(regexp.MustCompile(`^en`))} },
+			err:     errInvalidFilePattern,
+		},
+		{
+			name: "conflicting default",
+			options: func(string) []option {
+				return []option{WithDefault("en", "./translations/en_US.json"), WithDefaultIdentifier("pt")}
+			},
+			err: errDefaultAlreadyRegistered,
+		},
+	}
+
+	for _, tc := range ttErrors {
+		t.Run(tc.name, func(t *testing.T) {
+			translator, err := NewTranslator(tc.options(t.TempDir())...)
+			require.ErrorIs(t, err, tc.err)
+			require.Nil(t, translator)
+		})
+	}
+
+	t.Run("invalid file", func(t *testing.T) {
+		dir := t.TempDir()
+		writeFile(t, dir, "en.json", `{`)
+
+		translator, err := NewTranslator(WithDir(dir))
+		require.ErrorContains(t, err, "en.json")
+		require.Nil(t, translator)
+	})
+
+	t.Run("concurrent registrations", func(t *testing.T) {
+		dir := t.TempDir()
+		writeFile(t, dir, "de.json", `{"hello_world": "Hallo Welt"}`)
+
+		translator, err := NewTranslator(WithDefault("en", "./translations/en_US.json"))
+		require.NoError(t, err)
+
+		var wg sync.WaitGroup
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 10; i++ {
+				require.NoError(t, translator.Replace("pt", "./translations/pt_BR.json"))
+			}
+		}()
+
+		for i := 0; i < 10; i++ {
+			require.NoError(t, translator.RegisterDir(dir))
+		}
+
+		wg.Wait()
+		require.Equal(t, "Hallo Welt", translator.Get(Args{Identifier: "de", Localizer: "hello_world"}))
+	})
+
+	t.Run("missing dir", func(t *testing.T) {
+		translator, err := NewTranslator(WithDir("./missing"))
+		require.ErrorIs(t, err, os.ErrNotExist)
+		require.Nil(t, translator)
+	})
+}
+
+func TestTranslator_identifierOf(t *testing.T) {
+	tt := []struct {
+		name       string
+		identifier string
+		ok         bool
+	}{
+		{name: "en.json", identifier: "en", ok: true},
+		{name: "en_US.json", identifier: "en_US", ok: true},
+		{name: "en_US_items.json", identifier: "en_US", ok: true},
+		{name: "pt-BR.yaml", identifier: "pt-BR", ok: true},
+		{name: "zh_Hant_TW.po", identifier: "zh_Hant_TW", ok: true},
+		{name: "es_419_menu.json", identifier: "es_419", ok: true},
+		{name: "fil_items.json", identifier: "fil", ok: true},
+		{name: "notes.json", ok: false},
+		{name: "EN.json", ok: false},
+	}
+
+	tr := &translator{}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			identifier, ok := tr.identifierOf(tc.name)
+			require.Equal(t, tc.ok, ok)
+			require.Equal(t, tc.identifier, identifier)
+		})
+	}
+}
+
+func writeFile(t *testing.T, dir, name, content string) {
+	t.Helper()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(content), 0o600))
+}
@@ -8,30 +8,15 @@ import (
 
 func main() {
 	translator, err := gotr.NewTranslator(
-		gotr.WithDefault("en", "./translations/en_US.json"),
-		gotr.WithDefault("en", "./translations/en_US_items.json"),
+		gotr.WithDefaultIdentifier("en_US"),
+		gotr.WithDir("./translations"),
 	)
 	if err != nil {
 		fmt.Println(err)
 	}
 
-	err = translator.Register("pt", "./translations/pt_BR.json")
-	if err != nil {
-		fmt.Println(err)
-	}
-
-	argsPTArmorDescription := gotr.Args{
-		Identifier: "pt",
-		Localizer:  "items.equipments.armor.description",
-		Args: map[string]any{
-			"Name":  "John",
-			"Count": 10,
-		},
-		Count: 10,
-	}
-
 	argsPTArmorText := gotr.Args{
-		Identifier: "pt",
+		Identifier: "pt_BR",
 		Localizer:  "items.equipments.armor",
 		Args: map[string]any{
 			"Name":  "John",
@@ -41,7 +26,7 @@ func main() {
 	}
 
 	argsPTArmorFullText := gotr.Args{
-		Identifier: "pt",
+		Identifier: "pt_BR",
 		Localizer:  "{{.Name}} has {{.Count}} Armor.",
 		Args: map[string]any{
 			"Name":  "John",
@@ -51,13 +36,15 @@ func main() {
 	}
 
 	argsPTText2 := gotr.Args{
-		Identifier: "pt",
+		Identifier: "pt_BR",
 		Localizer:  "hello_world",
 		Count:      1,
 	}
 
 	fmt.Println(translator.Get(argsPTArmorText))
-	fmt.Println(translator.Get(argsPTArmorDescription))
+	if armor, ok := translator.Template("pt_BR", "items.equipments.armor"); ok {
+		fmt.Println(armor.Metadata.Description)
+	}
 	fmt.Println(translator.Get(argsPTArmorFullText))
 	fmt.Println(translator.Get(argsPTText2))
 }
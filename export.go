@@ -0,0 +1,94 @@
+package gotr
+
+import (
+	"io"
+	"sort"
+
+	"github.com/leoviggiano/gotr/internal/catalog"
+)
+
+// ExportPO writes the templates registered for identifier as a gettext PO catalog, using the
+// default locale's texts as msgid and the key paths as msgctxt, so it can be loaded back by Register.
+func (t *translator) ExportPO(identifier string, w io.Writer) error {
+	return catalog.EncodePO(w, identifier, t.messages(identifier))
+}
+
+// ExportAndroid writes the templates registered for identifier as an Android strings.xml file,
+// naming every resource after its key path.
+func (t *translator) ExportAndroid(identifier string, w io.Writer) error {
+	return catalog.EncodeAndroid(w, t.messages(identifier))
+}
+
+// ExportStrings writes the templates of identifier without plural forms as an Apple .strings file.
+func (t *translator) ExportStrings(identifier string, w io.Writer) error {
+	return catalog.EncodeStrings(w, t.messages(identifier))
+}
+
+// ExportStringsdict writes the plural templates of identifier as an Apple .stringsdict file.
+func (t *translator) ExportStringsdict(identifier string, w io.Writer) error {
+	return catalog.EncodeStringsdict(w, t.messages(identifier))
+}
+
+// ExportARB writes the templates registered for identifier as a Flutter ARB file, with ICU
+// placeholders and {Count, plural, ...} messages.
+func (t *translator) ExportARB(identifier string, w io.Writer) error {
+	return catalog.EncodeARB(w, identifier, t.messages(identifier))
+}
+
+// ExportProperties writes the templates registered for identifier as a Java .properties file keyed
+// by key path.
+func (t *translator) ExportProperties(identifier string, w io.Writer) error {
+	return catalog.EncodeProperties(w, t.messages(identifier))
+}
+
+// messages pairs every key path of the default locale and of identifier with its source text, its
+// translation and its metadata, sorted by key path.
+func (t *translator) messages(identifier string) []catalog.Message {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	defaultTemplates := t.templates[t.defaultIdentifier]
+	templates := t.templates[identifier]
+
+	keys := make(map[string]struct{})
+	for _, m := range []map[string]template{defaultTemplates, templates} {
+		for key, tpl := range m {
+			if tpl.path == key {
+				keys[key] = struct{}{}
+			}
+		}
+	}
+
+	messages := make([]catalog.Message, 0, len(keys))
+	for key := range keys {
+		source, hasSource := defaultTemplates[key]
+		target, hasTarget := templates[key]
+
+		if !hasSource {
+			source = target
+		}
+
+		if !hasTarget {
+			target = template{}
+		}
+
+		meta := source.metadata
+		if meta.Empty() {
+			meta = target.metadata
+		}
+
+		messages = append(messages, catalog.Message{
+			Key:      key,
+			Plural:   !source.plain() || (hasTarget && !target.plain()),
+			Source:   source.forms(),
+			Target:   target.forms(),
+			Metadata: meta,
+		})
+	}
+
+	sort.Slice(messages, func(i, j int) bool {
+		return messages[i].Key < messages[j].Key
+	})
+
+	return messages
+}
@@ -0,0 +1,114 @@
+package gotr
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTranslator_ExportPO(t *testing.T) {
+	translator, err := NewTranslator(
+		WithDefault("en", "./translations/en_US.json"),
+		WithDefault("en", "./translations/en_US_items.json"),
+	)
+	require.NoError(t, err)
+
+	err = translator.Register("pt", "./translations/pt_BR.json")
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	err = translator.ExportPO("pt", &buf)
+	require.NoError(t, err)
+
+	po := buf.String()
+	require.Contains(t, po, "\"Language: pt\\n\"")
+	require.Contains(t, po, "msgctxt \"hello_world\"\nmsgid \"Hello World\"\nmsgstr \"Olá Mundo\"\n")
+	require.Contains(t, po, "msgctxt \"hello_world2\"\nmsgid \"Hello World 2\"\nmsgstr \"\"\n")
+	require.Contains(t, po, "#. Armor text\n"+
+		"#. none: {{.Name}} has no Armor.\n"+
+		"msgctxt \"items.equipments.armor\"\n"+
+		"msgid \"{{.Name}} has {{.Count}} Armor.\"\n"+
+		"msgid_plural \"{{.Name}} has {{.Count}} Armors.\"\n"+
+		"msgstr[0] \"{{.Name}} não tem Armadura.\"\n"+
+		"msgstr[1] \"{{.Name}} tem {{.Count}} Armadura.\"\n"+
+		"msgstr[2] \"{{.Name}} tem {{.Count}} Armaduras.\"\n")
+
+	t.Run("round trip", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "pt_BR.po")
+		require.NoError(t, os.WriteFile(path, buf.Bytes(), 0o600))
+
+		err := translator.Register("pt-po", path)
+		require.NoError(t, err)
+
+		for _, count := range []int{0, 1, 2} {
+			args := Args{
+				Localizer: "{{.Name}} has {{.Count}} Armor.",
+				Args:      map[string]any{"Name": "John", "Count": count},
+				Count:     count,
+			}
+
+			args.Identifier = "pt"
+			expected := translator.Get(args)
+
+			args.Identifier = "pt-po"
+			require.Equal(t, expected, translator.Get(args))
+		}
+
+		require.Equal(t, "Hello World 2", translator.Get(Args{Identifier: "pt-po", Localizer: "hello_world2"}))
+	})
+}
+
+func TestTranslator_ExportFormats(t *testing.T) {
+	translator, err := NewTranslator(
+		WithDefault("en", "./translations/en_US.json"),
+		WithDefault("en", "./translations/en_US_items.json"),
+	)
+	require.NoError(t, err)
+
+	err = translator.Register("pt", "./translations/pt_BR.json")
+	require.NoError(t, err)
+
+	tt := []struct {
+		name   string
+		file   string
+		export func(identifier string, w io.Writer) error
+		keys   []string
+	}{
+		{name: "android", file: "strings.xml", export: translator.ExportAndroid, keys: []string{"hello_world", "texts.welcome", "items.equipments.armor"}},
+		{name: "strings", file: "Localizable.strings", export: translator.ExportStrings, keys: []string{"hello_world", "texts.welcome"}},
+		{name: "stringsdict", file: "Localizable.stringsdict", export: translator.ExportStringsdict, keys: []string{"items.equipments.armor", "items.consumables.mana-potion"}},
+		{name: "arb", file: "app_pt.arb", export: translator.ExportARB, keys: []string{"hello_world", "texts.welcome", "items.equipments.armor"}},
+		{name: "properties", file: "messages_pt.properties", export: translator.ExportProperties, keys: []string{"hello_world", "texts.goodbye", "items.equipments.armor"}},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			err := tc.export("pt", &buf)
+			require.NoError(t, err)
+
+			path := filepath.Join(t.TempDir(), tc.file)
+			require.NoError(t, os.WriteFile(path, buf.Bytes(), 0o600))
+
+			identifier := "pt-" + tc.name
+			err = translator.Register(identifier, path)
+			require.NoError(t, err)
+
+			for _, key := range tc.keys {
+				for _, count := range []int{0, 1, 2} {
+					args := Args{Localizer: key, Args: map[string]any{"Name": "John", "Count": count}, Count: count}
+
+					args.Identifier = "pt"
+					expected := translator.Get(args)
+
+					args.Identifier = identifier
+					require.Equal(t, expected, translator.Get(args), key)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,307 @@
+package catalog
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+var ErrInvalidAndroid = errors.New("invalid android resources file")
+
+type androidResources struct {
+	XMLName xml.Name        `xml:"resources"`
+	Strings []androidString `xml:"string"`
+	Plurals []androidPlural `xml:"plurals"`
+}
+
+type androidString struct {
+	XMLName xml.Name `xml:"string"`
+	Name    string   `xml:"name,attr"`
+	Value   string   `xml:",innerxml"`
+}
+
+type androidPlural struct {
+	XMLName xml.Name      `xml:"plurals"`
+	Name    string        `xml:"name,attr"`
+	Items   []androidItem `xml:"item"`
+}
+
+type androidItem struct {
+	Quantity string `xml:"quantity,attr"`
+	Value    string `xml:",innerxml"`
+}
+
+// DecodeAndroid decodes an Android strings.xml file. Every <string> is stored under its name, and
+// the "one", "other" and "zero" items of a <plurals> become its singular, plural and none forms.
+// Format specifiers become placeholders named by the id of their <xliff:g> element, or {{.ArgN}}.
+func DecodeAndroid(r io.Reader) (map[string]any, error) {
+	var res androidResources
+	if err := xml.NewDecoder(r).Decode(&res); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidAndroid, err)
+	}
+
+	tree := make(map[string]any)
+	for _, s := range res.Strings {
+		value, err := unescapeAndroid(s.Value)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s: %v", ErrInvalidAndroid, s.Name, err)
+		}
+
+		if err := SetPath(tree, s.Name, value); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, p := range res.Plurals {
+		quantities := make(map[string]string)
+		for _, item := range p.Items {
+			value, err := unescapeAndroid(item.Value)
+			if err != nil {
+				return nil, fmt.Errorf("%w: %s: %v", ErrInvalidAndroid, p.Name, err)
+			}
+
+			quantities[item.Quantity] = value
+		}
+
+		if err := SetPath(tree, p.Name, quantityForms(quantities)); err != nil {
+			return nil, err
+		}
+	}
+
+	return tree, nil
+}
+
+// quantityForms maps CLDR plural categories onto the singular, plural and none forms.
+func quantityForms(quantities map[string]string) map[string]any {
+	other := quantities["other"]
+	forms := map[string]any{"singular": other, "plural": other, "none": other}
+
+	if one, ok := quantities["one"]; ok {
+		forms["singular"] = one
+	}
+
+	if zero, ok := quantities["zero"]; ok {
+		forms["none"] = zero
+	}
+
+	return forms
+}
+
+// unescapeAndroid resolves the XML entities and the backslash escapes of a resource value, and
+// removes the double quotes Android uses to keep whitespace.
+func unescapeAndroid(inner string) (string, error) {
+	var (
+		text strings.Builder
+		args []string
+		id   string // id of the <xliff:g> element the text is in
+	)
+
+	dec := xml.NewDecoder(strings.NewReader("<v>" + inner + "</v>"))
+	for {
+		token, err := dec.Token()
+		if err == io.EOF {
+			break
+		}
+
+		if err != nil {
+			return "", err
+		}
+
+		switch token := token.(type) {
+		case xml.StartElement:
+			if token.Name.Local == "g" {
+				id = attr(token, "id")
+			}
+		case xml.EndElement:
+			if token.Name.Local == "g" {
+				id = ""
+			}
+		case xml.CharData:
+			if match := printfVerb.FindStringSubmatch(string(token)); id != "" && match != nil && match[1] != "" {
+				position, _ := strconv.Atoi(match[1])
+				for len(args) < position {
+					args = append(args, "")
+				}
+
+				args[position-1] = id
+			}
+
+			text.Write(token)
+		}
+	}
+
+	value := text.String()
+	var b strings.Builder
+	for i := 0; i < len(value); i++ {
+		c := value[i]
+		switch {
+		case c == '\\' && i+1 < len(value):
+			i++
+			switch value[i] {
+			case 'n':
+				b.WriteByte('\n')
+			case 't':
+				b.WriteByte('\t')
+			default:
+				b.WriteByte(value[i])
+			}
+		case c == '"':
+			// Unescaped quotes only delimit text whose whitespace must be kept.
+		default:
+			b.WriteByte(c)
+		}
+	}
+
+	return fromPrintf(b.String(), args), nil
+}
+
+func attr(element xml.StartElement, name string) string {
+	for _, a := range element.Attr {
+		if a.Name.Local == name {
+			return a.Value
+		}
+	}
+
+	return ""
+}
+
+// EncodeAndroid writes the target texts of messages as an Android strings.xml file, using
+// <plurals> with "zero", "one" and "other" items for plural messages. Key paths become resource
+// names by replacing what Android doesn't allow with underscores, and placeholders become
+// positional format specifiers, %1$d for Count and %N$s for the others, in an <xliff:g> element
+// keeping their name. The metadata of a message is written as comments before its element.
+// Untranslated messages are skipped.
+func EncodeAndroid(w io.Writer, messages []Message) error {
+	var strs, plurals bytes.Buffer
+	names := make(map[string]string)
+	element := func(b *bytes.Buffer, notes []string, v any) error {
+		for _, note := range notes {
+			// "--" may not appear in an XML comment.
+			b.WriteString("    <!-- " + strings.ReplaceAll(note, "--", "- -") + " -->\n")
+		}
+
+		data, err := xml.MarshalIndent(v, "    ", "    ")
+		if err != nil {
+			return err
+		}
+
+		b.Write(data)
+		b.WriteString("\n")
+		return nil
+	}
+
+	for _, m := range messages {
+		if m.Target.Empty() {
+			continue
+		}
+
+		name := androidName(m.Key)
+		if key, ok := names[name]; ok {
+			return fmt.Errorf("%w: %s and %s are both named %s", ErrInvalidAndroid, key, m.Key, name)
+		}
+		names[name] = m.Key
+
+		args := printfArguments(m.Target, false)
+		if !m.Plural {
+			err := element(&strs, m.Metadata.Notes(), androidString{Name: name, Value: androidText(m.Target.Singular, args)})
+			if err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		err := element(&plurals, m.Metadata.Notes(), androidPlural{
+			Name: name,
+			Items: []androidItem{
+				{Quantity: "zero", Value: androidText(m.Target.None, args)},
+				{Quantity: "one", Value: androidText(m.Target.Singular, args)},
+				{Quantity: "other", Value: androidText(m.Target.Plural, args)},
+			},
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	var b bytes.Buffer
+	b.WriteString(xml.Header + `<resources xmlns:xliff="` + xliffNamespace + `">` + "\n")
+	b.Write(strs.Bytes())
+	b.Write(plurals.Bytes())
+	b.WriteString("</resources>\n")
+
+	_, err := w.Write(b.Bytes())
+	return err
+}
+
+// xliffNamespace is the namespace of the <xliff:g> elements naming format specifiers.
+const xliffNamespace = "urn:oasis:names:tc:xliff:document:1.2"
+
+// androidName turns a key path into a resource name, made of letters, digits, underscores and dots
+// and not starting with a digit.
+func androidName(key string) string {
+	name := []byte(key)
+	for i, c := range name {
+		if c != '_' && c != '.' && (c < 'a' || c > 'z') && (c < 'A' || c > 'Z') && (c < '0' || c > '9') {
+			name[i] = '_'
+		}
+	}
+
+	if len(name) == 0 || name[0] >= '0' && name[0] <= '9' {
+		return "_" + string(name)
+	}
+
+	return string(name)
+}
+
+// androidText escapes text and replaces its placeholders with the format specifiers of args, each
+// in an <xliff:g> element with the name of its placeholder.
+func androidText(text string, args []string) string {
+	escaped := escapeAndroid(toPrintf(text, args, "s"))
+	return printfVerb.ReplaceAllStringFunc(escaped, func(spec string) string {
+		position, _ := strconv.Atoi(printfVerb.FindStringSubmatch(spec)[1])
+		if position < 1 || position > len(args) {
+			return spec
+		}
+
+		return `<xliff:g id="` + args[position-1] + `">` + spec + "</xliff:g>"
+	})
+}
+
+func escapeAndroid(s string) string {
+	var b strings.Builder
+	for i, r := range s {
+		switch r {
+		case '\\':
+			b.WriteString(`\\`)
+		case '\'':
+			b.WriteString(`\'`)
+		case '"':
+			b.WriteString(`\"`)
+		case '\n':
+			b.WriteString(`\n`)
+		case '\t':
+			b.WriteString(`\t`)
+		case '&':
+			b.WriteString("&amp;")
+		case '<':
+			b.WriteString("&lt;")
+		case '>':
+			b.WriteString("&gt;")
+		case '@', '?':
+			if i == 0 {
+				b.WriteByte('\\')
+			}
+
+			b.WriteRune(r)
+		default:
+			b.WriteRune(r)
+		}
+	}
+
+	return b.String()
+}
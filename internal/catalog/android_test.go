@@ -0,0 +1,117 @@
+package catalog
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/leoviggiano/gotr/internal/metadata"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecodeAndroid(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		xml := `<?xml version="1.0" encoding="utf-8"?>
+<resources>
+    <!-- Home screen -->
+    <string name="hello_world">Olá Mundo</string>
+    <string name="texts.welcome">Bem-vindo ao \'meu\' jogo &amp; \"diversão\"!\nTchau</string>
+    <string name="texts.spaces">"  espaços  "</string>
+    <string name="texts.at">\@home</string>
+    <string name="texts.format">%1$s has %2$d items, <xliff:g id="Name" example="John">%3$s</xliff:g> 100%%</string>
+    <string name="app_name" translatable="false">Gotr</string>
+    <plurals name="items.armor">
+        <item quantity="zero">{{.Name}} não tem Armadura.</item>
+        <item quantity="one">{{.Name}} tem {{.Count}} Armadura.</item>
+        <item quantity="other">{{.Name}} tem {{.Count}} Armaduras.</item>
+    </plurals>
+    <plurals name="items.potion">
+        <item quantity="other">{{.Count}} Poções</item>
+    </plurals>
+</resources>`
+
+		tree, err := DecodeAndroid(strings.NewReader(xml))
+		require.NoError(t, err)
+		require.Equal(t, map[string]any{
+			"hello_world": "Olá Mundo",
+			"app_name":    "Gotr",
+			"texts": map[string]any{
+				"welcome": "Bem-vindo ao 'meu' jogo & \"diversão\"!\nTchau",
+				"spaces":  "  espaços  ",
+				"at":      "@home",
+				"format":  "{{.Arg1}} has {{.Arg2}} items, {{.Name}} 100%",
+			},
+			"items": map[string]any{
+				"armor": map[string]any{
+					"singular": "{{.Name}} tem {{.Count}} Armadura.",
+					"plural":   "{{.Name}} tem {{.Count}} Armaduras.",
+					"none":     "{{.Name}} não tem Armadura.",
+				},
+				"potion": map[string]any{"singular": "{{.Count}} Poções", "plural": "{{.Count}} Poções", "none": "{{.Count}} Poções"},
+			},
+		}, tree)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tree, err := DecodeAndroid(strings.NewReader(`<resources><string name="a">`))
+		require.ErrorIs(t, err, ErrInvalidAndroid)
+		require.Nil(t, tree)
+	})
+}
+
+func TestEncodeAndroid(t *testing.T) {
+	messages := []Message{
+		{Key: "hello_world", Target: Forms{Singular: "Olá Mundo", Plural: "Olá Mundo", None: "Olá Mundo"}},
+		{Key: "untranslated", Source: Forms{Singular: "Untranslated"}},
+		{Key: "texts.quote", Target: Forms{Singular: "@It's \"<b>\" & more"}},
+		{Key: "texts.sale-banner", Target: Forms{Singular: "{{.Name}}: 50% off"}},
+		{
+			Key:      "items.armor",
+			Plural:   true,
+			Target:   Forms{Singular: "{{.Name}} tem {{.Count}} Armadura", Plural: "{{.Name}} tem {{.Count}} Armaduras", None: "Sem Armadura"},
+			Metadata: metadata.Metadata{Description: "Armor -- of the inventory", Tags: []string{"items"}},
+		},
+	}
+
+	var buf bytes.Buffer
+	err := EncodeAndroid(&buf, messages)
+	require.NoError(t, err)
+	require.Equal(t, `<?xml version="1.0" encoding="UTF-8"?>
+<resources xmlns:xliff="urn:oasis:names:tc:xliff:document:1.2">
+    <string name="hello_world">Olá Mundo</string>
+    <string name="texts.quote">\@It\'s \"&lt;b&gt;\" &amp; more</string>
+    <string name="texts.sale_banner"><xliff:g id="Name">%1$s</xliff:g>: 50%% off</string>
+    <!-- Armor - - of the inventory -->
+    <!-- Tags: items -->
+    <plurals name="items.armor">
+        <item quantity="zero">Sem Armadura</item>
+        <item quantity="one"><xliff:g id="Name">%2$s</xliff:g> tem <xliff:g id="Count">%1$d</xliff:g> Armadura</item>
+        <item quantity="other"><xliff:g id="Name">%2$s</xliff:g> tem <xliff:g id="Count">%1$d</xliff:g> Armaduras</item>
+    </plurals>
+</resources>
+`, buf.String())
+
+	tree, err := DecodeAndroid(&buf)
+	require.NoError(t, err)
+	require.Equal(t, map[string]any{
+		"hello_world": "Olá Mundo",
+		"texts":       map[string]any{"quote": "@It's \"<b>\" & more", "sale_banner": "{{.Name}}: 50% off"},
+		"items": map[string]any{
+			"armor": map[string]any{
+				"singular": "{{.Name}} tem {{.Count}} Armadura",
+				"plural":   "{{.Name}} tem {{.Count}} Armaduras",
+				"none":     "Sem Armadura",
+			},
+		},
+	}, tree)
+
+	t.Run("names taken", func(t *testing.T) {
+		messages := []Message{
+			{Key: "texts.sale-banner", Target: Forms{Singular: "Sale"}},
+			{Key: "texts.sale_banner", Target: Forms{Singular: "Sale"}},
+		}
+
+		err := EncodeAndroid(&bytes.Buffer{}, messages)
+		require.ErrorIs(t, err, ErrInvalidAndroid)
+	})
+}
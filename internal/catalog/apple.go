@@ -0,0 +1,469 @@
+package catalog
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode/utf16"
+	"unicode/utf8"
+
+	"github.com/leoviggiano/gotr/internal/keypath"
+)
+
+var (
+	ErrInvalidStrings     = errors.New("invalid strings file")
+	ErrInvalidStringsdict = errors.New("invalid stringsdict file")
+)
+
+// DecodeStrings decodes an Apple .strings file, in UTF-8 or UTF-16 with a byte order mark, storing
+// every value under its key. Keys with whitespace or empty path segments, like "Loading...", are
+// texts and keep their dots. Format specifiers become the placeholders named by an "Arguments:"
+// comment before the entry, or {{.ArgN}}.
+func DecodeStrings(r io.Reader) (map[string]any, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	text, err := decodeUTF16(data)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &stringsParser{input: text}
+	tree := make(map[string]any)
+
+	for {
+		p.comment = ""
+		p.skip()
+		if p.pos >= len(p.input) {
+			return tree, nil
+		}
+
+		args := parseArguments(p.comment)
+		key, err := p.token()
+		if err != nil {
+			return nil, err
+		}
+
+		if err := p.expect('='); err != nil {
+			return nil, err
+		}
+
+		value, err := p.token()
+		if err != nil {
+			return nil, err
+		}
+
+		if err := p.expect(';'); err != nil {
+			return nil, err
+		}
+
+		if err := SetPath(tree, stringsKey(key), fromPrintf(value, args)); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// stringsKey returns the key path of a .strings key, escaping the dots of keys that are texts.
+func stringsKey(key string) string {
+	if strings.ContainsAny(key, " \t\r\n") || slices.Contains(keypath.Split(key), "") {
+		return keypath.Escape(key)
+	}
+
+	return key
+}
+
+func decodeUTF16(data []byte) (string, error) {
+	var order binary.ByteOrder
+	switch {
+	case bytes.HasPrefix(data, []byte{0xff, 0xfe}):
+		order = binary.LittleEndian
+	case bytes.HasPrefix(data, []byte{0xfe, 0xff}):
+		order = binary.BigEndian
+	default:
+		data = bytes.TrimPrefix(data, []byte{0xef, 0xbb, 0xbf})
+		if !utf8.Valid(data) {
+			return "", fmt.Errorf("%w: invalid encoding", ErrInvalidStrings)
+		}
+
+		return string(data), nil
+	}
+
+	data = data[2:]
+	if len(data)%2 != 0 {
+		return "", fmt.Errorf("%w: invalid utf-16", ErrInvalidStrings)
+	}
+
+	units := make([]uint16, len(data)/2)
+	for i := range units {
+		units[i] = order.Uint16(data[i*2:])
+	}
+
+	return string(utf16.Decode(units)), nil
+}
+
+type stringsParser struct {
+	input   string
+	pos     int
+	comment string // last comment skipped
+}
+
+// skip moves past whitespace and comments, keeping the last comment.
+func (p *stringsParser) skip() {
+	for p.pos < len(p.input) {
+		rest := p.input[p.pos:]
+		switch {
+		case strings.HasPrefix(rest, "/*"):
+			end := strings.Index(rest[2:], "*/")
+			if end < 0 {
+				p.pos = len(p.input)
+				return
+			}
+
+			p.comment = rest[2 : end+2]
+			p.pos += end + 4
+		case strings.HasPrefix(rest, "//"):
+			end := strings.IndexByte(rest, '\n')
+			if end < 0 {
+				p.pos = len(p.input)
+				return
+			}
+
+			p.comment = rest[2:end]
+			p.pos += end + 1
+		case strings.ContainsRune(" \t\r\n", rune(rest[0])):
+			p.pos++
+		default:
+			return
+		}
+	}
+}
+
+func (p *stringsParser) expect(c byte) error {
+	p.skip()
+	if p.pos >= len(p.input) || p.input[p.pos] != c {
+		return fmt.Errorf("%w: expected %q at offset %d", ErrInvalidStrings, c, p.pos)
+	}
+
+	p.pos++
+	return nil
+}
+
+// token reads a quoted string or an unquoted word.
+func (p *stringsParser) token() (string, error) {
+	p.skip()
+	if p.pos >= len(p.input) {
+		return "", fmt.Errorf("%w: unexpected end of file", ErrInvalidStrings)
+	}
+
+	if p.input[p.pos] != '"' {
+		start := p.pos
+		for p.pos < len(p.input) && isStringsWord(p.input[p.pos]) {
+			p.pos++
+		}
+
+		if start == p.pos {
+			return "", fmt.Errorf("%w: unexpected %q at offset %d", ErrInvalidStrings, p.input[p.pos], p.pos)
+		}
+
+		return p.input[start:p.pos], nil
+	}
+
+	var b strings.Builder
+	for p.pos++; p.pos < len(p.input); p.pos++ {
+		c := p.input[p.pos]
+		switch c {
+		case '"':
+			p.pos++
+			return b.String(), nil
+
+		case '\\':
+			p.pos++
+			if p.pos >= len(p.input) {
+				break
+			}
+
+			switch e := p.input[p.pos]; e {
+			case 'n':
+				b.WriteByte('\n')
+			case 't':
+				b.WriteByte('\t')
+			case 'r':
+				b.WriteByte('\r')
+			case 'U', 'u':
+				if p.pos+4 >= len(p.input) {
+					return "", fmt.Errorf("%w: invalid unicode escape at offset %d", ErrInvalidStrings, p.pos)
+				}
+
+				code, err := strconv.ParseUint(p.input[p.pos+1:p.pos+5], 16, 16)
+				if err != nil {
+					return "", fmt.Errorf("%w: invalid unicode escape at offset %d", ErrInvalidStrings, p.pos)
+				}
+
+				b.WriteRune(rune(code))
+				p.pos += 4
+			default:
+				b.WriteByte(e)
+			}
+
+		default:
+			b.WriteByte(c)
+		}
+	}
+
+	return "", fmt.Errorf("%w: unterminated string", ErrInvalidStrings)
+}
+
+func isStringsWord(c byte) bool {
+	return c == '_' || c == '.' || c == '-' || c == '$' || c == '/' || c == ':' ||
+		(c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9')
+}
+
+// EncodeStrings writes the target texts of the messages without plural forms as an Apple .strings
+// file. Placeholders become positional format specifiers, %N$d for Count and %N$@ for the others,
+// named in an "Arguments:" comment before the entry. Plural messages belong to a .stringsdict file
+// and untranslated messages are skipped.
+func EncodeStrings(w io.Writer, messages []Message) error {
+	for _, m := range messages {
+		if m.Plural || m.Target.Empty() {
+			continue
+		}
+
+		args := printfArguments(m.Target, false)
+		if len(args) > 0 {
+			if _, err := fmt.Fprintf(w, "/* %s%s */\n", argumentsNote, strings.Join(args, ", ")); err != nil {
+				return err
+			}
+		}
+
+		_, err := fmt.Fprintf(w, "%s = %s;\n", quoteStrings(m.Key), quoteStrings(toPrintf(m.Target.Singular, args, "@")))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func quoteStrings(s string) string {
+	r := strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`, "\t", `\t`, "\r", `\r`)
+	return `"` + r.Replace(s) + `"`
+}
+
+var stringsdictVariable = regexp.MustCompile(`%#@([^@]+)@`)
+
+// DecodeStringsdict decodes an Apple .stringsdict file. The "one", "other" and "zero" rules of the
+// first variable of every format become its singular, plural and none forms. Format specifiers
+// become the placeholders named by an "Arguments:" comment before the entry, or {{.Count}} for the
+// first argument, the variable's, and {{.ArgN}} for the others.
+func DecodeStringsdict(r io.Reader) (map[string]any, error) {
+	root, comments, err := decodePlist(r)
+	if err != nil {
+		return nil, err
+	}
+
+	entries, ok := root.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("%w: root is not a dict", ErrInvalidStringsdict)
+	}
+
+	tree := make(map[string]any)
+	for key, v := range entries {
+		entry, ok := v.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("%w: %s is not a dict", ErrInvalidStringsdict, key)
+		}
+
+		format, _ := entry["NSStringLocalizedFormatKey"].(string)
+		match := stringsdictVariable.FindStringSubmatch(format)
+		if match == nil {
+			return nil, fmt.Errorf("%w: %s has no plural variable", ErrInvalidStringsdict, key)
+		}
+
+		rules, ok := entry[match[1]].(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("%w: %s has no rules for %s", ErrInvalidStringsdict, key, match[1])
+		}
+
+		args := parseArguments(comments[key])
+		if args == nil {
+			args = []string{countArgument}
+		}
+
+		quantities := make(map[string]string)
+		for _, category := range []string{"zero", "one", "other"} {
+			if text, ok := rules[category].(string); ok {
+				quantities[category] = strings.Replace(fromPrintf(format, args), match[0], fromPrintf(text, args), 1)
+			}
+		}
+
+		if err := SetPath(tree, stringsKey(key), quantityForms(quantities)); err != nil {
+			return nil, err
+		}
+	}
+
+	return tree, nil
+}
+
+// decodePlist decodes the dict, array, string and number values of an XML property list, along with
+// the comments before the keys of its root dict.
+func decodePlist(r io.Reader) (any, map[string]string, error) {
+	dec := xml.NewDecoder(r)
+	for {
+		token, err := dec.Token()
+		if err != nil {
+			return nil, nil, fmt.Errorf("%w: %v", ErrInvalidStringsdict, err)
+		}
+
+		start, ok := token.(xml.StartElement)
+		if ok && start.Name.Local != "plist" {
+			comments := make(map[string]string)
+			root, err := decodePlistValue(dec, start, comments)
+			return root, comments, err
+		}
+	}
+}
+
+// decodePlistValue decodes the value start opens, keeping the comment before each key of a dict in
+// comments when it is not nil.
+func decodePlistValue(dec *xml.Decoder, start xml.StartElement, comments map[string]string) (any, error) {
+	switch start.Name.Local {
+	case "dict":
+		dict := make(map[string]any)
+		var key *string
+		var comment string
+
+		for {
+			token, err := dec.Token()
+			if err != nil {
+				return nil, fmt.Errorf("%w: %v", ErrInvalidStringsdict, err)
+			}
+
+			switch token := token.(type) {
+			case xml.EndElement:
+				return dict, nil
+
+			case xml.Comment:
+				comment = string(token)
+
+			case xml.StartElement:
+				if token.Name.Local == "key" {
+					var k string
+					if err := dec.DecodeElement(&k, &token); err != nil {
+						return nil, fmt.Errorf("%w: %v", ErrInvalidStringsdict, err)
+					}
+
+					if comments != nil && comment != "" {
+						comments[k] = comment
+					}
+
+					key = &k
+					comment = ""
+					continue
+				}
+
+				if key == nil {
+					return nil, fmt.Errorf("%w: value without key", ErrInvalidStringsdict)
+				}
+
+				value, err := decodePlistValue(dec, token, nil)
+				if err != nil {
+					return nil, err
+				}
+
+				dict[*key] = value
+				key = nil
+			}
+		}
+
+	case "array":
+		var list []any
+		for {
+			token, err := dec.Token()
+			if err != nil {
+				return nil, fmt.Errorf("%w: %v", ErrInvalidStringsdict, err)
+			}
+
+			switch token := token.(type) {
+			case xml.EndElement:
+				return list, nil
+			case xml.StartElement:
+				value, err := decodePlistValue(dec, token, nil)
+				if err != nil {
+					return nil, err
+				}
+
+				list = append(list, value)
+			}
+		}
+
+	default:
+		var text string
+		if err := dec.DecodeElement(&text, &start); err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidStringsdict, err)
+		}
+
+		return text, nil
+	}
+}
+
+const stringsdictHeader = `<?xml version="1.0" encoding="UTF-8"?>
+<!DOCTYPE plist PUBLIC "-//Apple//DTD PLIST 1.0//EN" "http://www.apple.com/DTDs/PropertyList-1.0.dtd">
+<plist version="1.0">
+<dict>
+`
+
+// EncodeStringsdict writes the target texts of the plural messages as an Apple .stringsdict file,
+// with "zero", "one" and "other" rules for a "count" variable. The count is the first argument, %1$d
+// in the rules, and the other placeholders follow as %N$@, named in an "Arguments:" comment before
+// the entry.
+func EncodeStringsdict(w io.Writer, messages []Message) error {
+	var b bytes.Buffer
+	b.WriteString(stringsdictHeader)
+
+	element := func(indent, name, value string) {
+		b.WriteString(indent + "<" + name + ">")
+		_ = xml.EscapeText(&b, []byte(value))
+		b.WriteString("</" + name + ">\n")
+	}
+
+	for _, m := range messages {
+		if !m.Plural || m.Target.Empty() {
+			continue
+		}
+
+		args := printfArguments(m.Target, true)
+		b.WriteString("    <!-- " + argumentsNote + strings.Join(args, ", ") + " -->\n")
+		element("    ", "key", m.Key)
+		b.WriteString("    <dict>\n")
+		element("        ", "key", "NSStringLocalizedFormatKey")
+		element("        ", "string", "%#@count@")
+		element("        ", "key", "count")
+		b.WriteString("        <dict>\n")
+		element("            ", "key", "NSStringFormatSpecTypeKey")
+		element("            ", "string", "NSStringPluralRuleType")
+		element("            ", "key", "NSStringFormatValueTypeKey")
+		element("            ", "string", "d")
+		element("            ", "key", "zero")
+		element("            ", "string", toPrintf(m.Target.None, args, "@"))
+		element("            ", "key", "one")
+		element("            ", "string", toPrintf(m.Target.Singular, args, "@"))
+		element("            ", "key", "other")
+		element("            ", "string", toPrintf(m.Target.Plural, args, "@"))
+		b.WriteString("        </dict>\n")
+		b.WriteString("    </dict>\n")
+	}
+
+	b.WriteString("</dict>\n</plist>\n")
+
+	_, err := w.Write(b.Bytes())
+	return err
+}
@@ -0,0 +1,184 @@
+package catalog
+
+import (
+	"bytes"
+	"encoding/binary"
+	"strings"
+	"testing"
+	"unicode/utf16"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecodeStrings(t *testing.T) {
+	strs := `/* Home screen */
+"hello_world" = "Olá Mundo";
+// Texts
+"texts.welcome" = "Bem-vindo ao \"meu\" jogo!\nTchau";
+texts.goodbye = "At\U00e9 logo!";
+"Loading..." = "Carregando...";
+"Wait. Please" = "Espere";
+/* Arguments: Count, Name */
+"texts.items" = "%2$@ tem %1$d itens, 100%%";
+"texts.format" = "%@ e %@";
+`
+
+	expected := map[string]any{
+		"hello_world": "Olá Mundo",
+		"texts": map[string]any{
+			"welcome": "Bem-vindo ao \"meu\" jogo!\nTchau",
+			"goodbye": "Até logo!",
+			"items":   "{{.Name}} tem {{.Count}} itens, 100%",
+			"format":  "{{.Arg1}} e {{.Arg2}}",
+		},
+		"Loading...":   "Carregando...",
+		"Wait. Please": "Espere",
+	}
+
+	t.Run("utf-8", func(t *testing.T) {
+		tree, err := DecodeStrings(strings.NewReader(strs))
+		require.NoError(t, err)
+		require.Equal(t, expected, tree)
+	})
+
+	t.Run("utf-16", func(t *testing.T) {
+		var buf bytes.Buffer
+		buf.Write([]byte{0xff, 0xfe})
+		require.NoError(t, binary.Write(&buf, binary.LittleEndian, utf16.Encode([]rune(strs))))
+
+		tree, err := DecodeStrings(&buf)
+		require.NoError(t, err)
+		require.Equal(t, expected, tree)
+	})
+
+	ttErrors := []struct {
+		name string
+		strs string
+	}{
+		{name: "missing semicolon", strs: `"a" = "A"`},
+		{name: "missing equals", strs: `"a" "A";`},
+		{name: "unterminated string", strs: `"a" = "A;`},
+		{name: "invalid token", strs: `"a" = {A};`},
+		{name: "invalid unicode escape", strs: `"a" = "\Uzzzz";`},
+		{name: "invalid encoding", strs: "\xff\x00"},
+	}
+
+	for _, tc := range ttErrors {
+		t.Run(tc.name, func(t *testing.T) {
+			tree, err := DecodeStrings(strings.NewReader(tc.strs))
+			require.ErrorIs(t, err, ErrInvalidStrings)
+			require.Nil(t, tree)
+		})
+	}
+}
+
+func TestEncodeStrings(t *testing.T) {
+	messages := []Message{
+		{Key: "hello_world", Target: Forms{Singular: "Olá \"Mundo\"\n"}},
+		{Key: "untranslated"},
+		{Key: "items.armor", Plural: true, Target: Forms{Singular: "Armadura"}},
+		{Key: "texts.welcome", Target: Forms{Singular: "{{.Name}} tem {{.Count}} itens, 100%"}},
+		{Key: `Loading\.\.\.`, Target: Forms{Singular: "Carregando..."}},
+	}
+
+	var buf bytes.Buffer
+	err := EncodeStrings(&buf, messages)
+	require.NoError(t, err)
+	require.Equal(t, "\"hello_world\" = \"Olá \\\"Mundo\\\"\\n\";\n"+
+		"/* Arguments: Count, Name */\n"+
+		"\"texts.welcome\" = \"%2$@ tem %1$d itens, 100%%\";\n"+
+		"\"Loading\\\\.\\\\.\\\\.\" = \"Carregando...\";\n", buf.String())
+
+	tree, err := DecodeStrings(&buf)
+	require.NoError(t, err)
+	require.Equal(t, map[string]any{
+		"hello_world": "Olá \"Mundo\"\n",
+		"texts":       map[string]any{"welcome": "{{.Name}} tem {{.Count}} itens, 100%"},
+		"Loading...":  "Carregando...",
+	}, tree)
+}
+
+func TestStringsdict(t *testing.T) {
+	t.Run("decode", func(t *testing.T) {
+		plist := `<?xml version="1.0" encoding="UTF-8"?>
+<plist version="1.0">
+<dict>
+    <key>items.armor</key>
+    <dict>
+        <key>NSStringLocalizedFormatKey</key>
+        <string>{{.Name}} tem %#@armors@</string>
+        <key>armors</key>
+        <dict>
+            <key>NSStringFormatSpecTypeKey</key>
+            <string>NSStringPluralRuleType</string>
+            <key>NSStringFormatValueTypeKey</key>
+            <string>d</string>
+            <key>one</key>
+            <string>{{.Count}} Armadura</string>
+            <key>other</key>
+            <string>%d Armaduras</string>
+        </dict>
+    </dict>
+</dict>
+</plist>`
+
+		tree, err := DecodeStringsdict(strings.NewReader(plist))
+		require.NoError(t, err)
+		require.Equal(t, map[string]any{
+			"items": map[string]any{
+				"armor": map[string]any{
+					"singular": "{{.Name}} tem {{.Count}} Armadura",
+					"plural":   "{{.Name}} tem {{.Count}} Armaduras",
+					"none":     "{{.Name}} tem {{.Count}} Armaduras",
+				},
+			},
+		}, tree)
+	})
+
+	t.Run("round trip", func(t *testing.T) {
+		messages := []Message{
+			{Key: "hello_world", Target: Forms{Singular: "Olá Mundo"}},
+			{
+				Key:    "items.armor",
+				Plural: true,
+				Target: Forms{Singular: "{{.Name}}: {{.Count}} Armadura", Plural: "{{.Name}}: {{.Count}} Armaduras", None: "Sem <Armadura>"},
+			},
+		}
+
+		var buf bytes.Buffer
+		err := EncodeStringsdict(&buf, messages)
+		require.NoError(t, err)
+		require.Contains(t, buf.String(), "    <!-- Arguments: Count, Name -->\n    <key>items.armor</key>\n")
+		require.Contains(t, buf.String(), "<key>zero</key>\n            <string>Sem &lt;Armadura&gt;</string>\n")
+		require.Contains(t, buf.String(), "<key>one</key>\n            <string>%2$@: %1$d Armadura</string>\n")
+		require.NotContains(t, buf.String(), "hello_world")
+
+		tree, err := DecodeStringsdict(&buf)
+		require.NoError(t, err)
+		require.Equal(t, map[string]any{
+			"items": map[string]any{
+				"armor": map[string]any{"singular": "{{.Name}}: {{.Count}} Armadura", "plural": "{{.Name}}: {{.Count}} Armaduras", "none": "Sem <Armadura>"},
+			},
+		}, tree)
+	})
+
+	ttErrors := []struct {
+		name  string
+		plist string
+	}{
+		{name: "invalid xml", plist: `<plist><dict>`},
+		{name: "root is not a dict", plist: `<plist><array></array></plist>`},
+		{name: "entry is not a dict", plist: `<plist><dict><key>a</key><string>A</string></dict></plist>`},
+		{name: "no variable", plist: `<plist><dict><key>a</key><dict><key>NSStringLocalizedFormatKey</key><string>A</string></dict></dict></plist>`},
+		{name: "no rules", plist: `<plist><dict><key>a</key><dict><key>NSStringLocalizedFormatKey</key><string>%#@n@</string></dict></dict></plist>`},
+		{name: "value without key", plist: `<plist><dict><string>A</string></dict></plist>`},
+	}
+
+	for _, tc := range ttErrors {
+		t.Run(tc.name, func(t *testing.T) {
+			tree, err := DecodeStringsdict(strings.NewReader(tc.plist))
+			require.ErrorIs(t, err, ErrInvalidStringsdict)
+			require.Nil(t, tree)
+		})
+	}
+}
@@ -0,0 +1,476 @@
+package catalog
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/leoviggiano/gotr/internal/placeholder"
+)
+
+var ErrInvalidARB = errors.New("invalid arb file")
+
+// arbCount is the argument plural ARB messages are selected by, matching the Count of gotr.Args.
+const arbCount = "Count"
+
+// DecodeARB decodes a Flutter ARB file. Every message is stored under its key, its ICU {name}
+// placeholders become {{.name}} and the "=1"/"one", "other" and "=0"/"zero" cases of a plural become
+// its singular, plural and none forms. The @key metadata and the global @@ attributes are skipped.
+func DecodeARB(r io.Reader) (map[string]any, error) {
+	var values map[string]any
+	if err := json.NewDecoder(r).Decode(&values); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidARB, err)
+	}
+
+	tree := make(map[string]any)
+	for key, v := range values {
+		if strings.HasPrefix(key, "@") {
+			continue
+		}
+
+		text, ok := v.(string)
+		if !ok {
+			return nil, fmt.Errorf("%w: %s is not a string", ErrInvalidARB, key)
+		}
+
+		p := &icuParser{input: text}
+		nodes, err := p.message("")
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s: %v", ErrInvalidARB, key, err)
+		}
+
+		if p.pos < len(p.input) {
+			return nil, fmt.Errorf("%w: %s: unexpected '}' at offset %d", ErrInvalidARB, key, p.pos)
+		}
+
+		var value any = renderICU(nodes, "")
+		if hasPlural(nodes) {
+			forms := make(map[string]any, len(PluralForms))
+			for _, form := range PluralForms {
+				forms[form] = renderICU(nodes, form)
+			}
+
+			value = forms
+		}
+
+		if err := SetPath(tree, key, value); err != nil {
+			return nil, err
+		}
+	}
+
+	return tree, nil
+}
+
+// icuNode is a piece of an ICU message: literal text, a placeholder or a plural argument.
+type icuNode struct {
+	text        string
+	placeholder string
+	plural      map[string][]icuNode
+}
+
+type icuParser struct {
+	input string
+	pos   int
+}
+
+// message parses nodes until the closing brace of the enclosing argument or the end of the input.
+// Inside a plural case, pluralArg names the argument "#" refers to.
+func (p *icuParser) message(pluralArg string) ([]icuNode, error) {
+	var nodes []icuNode
+	var text strings.Builder
+
+	flush := func() {
+		if text.Len() > 0 {
+			nodes = append(nodes, icuNode{text: text.String()})
+			text.Reset()
+		}
+	}
+
+	for p.pos < len(p.input) {
+		c := p.input[p.pos]
+		switch {
+		case c == '\'':
+			p.pos++
+			p.quoted(&text, pluralArg != "")
+
+		case c == '{':
+			flush()
+			node, err := p.argument()
+			if err != nil {
+				return nil, err
+			}
+
+			nodes = append(nodes, node)
+
+		case c == '}':
+			flush()
+			return nodes, nil
+
+		case c == '#' && pluralArg != "":
+			flush()
+			nodes = append(nodes, icuNode{placeholder: pluralArg})
+			p.pos++
+
+		default:
+			r, size := utf8.DecodeRuneInString(p.input[p.pos:])
+			text.WriteRune(r)
+			p.pos += size
+		}
+	}
+
+	flush()
+	return nodes, nil
+}
+
+// quoted handles the text after an apostrophe: a doubled apostrophe is an apostrophe, and one before a
+// syntax character quotes everything up to the next single apostrophe.
+func (p *icuParser) quoted(text *strings.Builder, inPlural bool) {
+	if p.pos >= len(p.input) || !isICUSyntax(p.input[p.pos], inPlural) {
+		text.WriteByte('\'')
+		return
+	}
+
+	if p.input[p.pos] == '\'' {
+		text.WriteByte('\'')
+		p.pos++
+		return
+	}
+
+	for p.pos < len(p.input) {
+		c := p.input[p.pos]
+		p.pos++
+
+		if c != '\'' {
+			text.WriteByte(c)
+			continue
+		}
+
+		if p.pos < len(p.input) && p.input[p.pos] == '\'' {
+			text.WriteByte('\'')
+			p.pos++
+			continue
+		}
+
+		return
+	}
+}
+
+func isICUSyntax(c byte, inPlural bool) bool {
+	return c == '{' || c == '}' || c == '\'' || c == '|' || (inPlural && c == '#')
+}
+
+// argument parses {name}, {name, type, style} and {name, plural, cases...}.
+func (p *icuParser) argument() (icuNode, error) {
+	start := p.pos
+	p.pos++
+
+	name, end, err := p.word(",}")
+	if err != nil {
+		return icuNode{}, err
+	}
+
+	if name == "" {
+		return icuNode{}, fmt.Errorf("empty argument at offset %d", start)
+	}
+
+	if end == '}' {
+		return icuNode{placeholder: name}, nil
+	}
+
+	kind, end, err := p.word(",}")
+	if err != nil {
+		return icuNode{}, err
+	}
+
+	switch kind {
+	case "plural", "selectordinal":
+		if end != ',' {
+			return icuNode{}, fmt.Errorf("plural without cases at offset %d", start)
+		}
+
+		cases, err := p.cases(name)
+		if err != nil {
+			return icuNode{}, err
+		}
+
+		return icuNode{plural: cases}, nil
+
+	case "select":
+		return icuNode{}, fmt.Errorf("select arguments are not supported, at offset %d", start)
+
+	default:
+		// Formatted arguments like {amount, number, currency} are plain placeholders.
+		for depth := 1; end == ',' && depth > 0; p.pos++ {
+			if p.pos >= len(p.input) {
+				return icuNode{}, fmt.Errorf("unterminated argument at offset %d", start)
+			}
+
+			switch p.input[p.pos] {
+			case '{':
+				depth++
+			case '}':
+				depth--
+			}
+		}
+
+		return icuNode{placeholder: name}, nil
+	}
+}
+
+// word reads a trimmed word up to one of the delimiters, consuming the delimiter.
+func (p *icuParser) word(delimiters string) (string, byte, error) {
+	start := p.pos
+	for p.pos < len(p.input) {
+		c := p.input[p.pos]
+		if strings.IndexByte(delimiters, c) >= 0 {
+			p.pos++
+			return strings.TrimSpace(p.input[start : p.pos-1]), c, nil
+		}
+
+		p.pos++
+	}
+
+	return "", 0, fmt.Errorf("unterminated argument at offset %d", start)
+}
+
+// cases parses the "selector {message}" pairs of a plural argument up to its closing brace.
+func (p *icuParser) cases(arg string) (map[string][]icuNode, error) {
+	cases := make(map[string][]icuNode)
+	for {
+		p.skipSpaces()
+		if p.pos >= len(p.input) {
+			return nil, fmt.Errorf("unterminated plural %s", arg)
+		}
+
+		if p.input[p.pos] == '}' {
+			p.pos++
+			break
+		}
+
+		start := p.pos
+		for p.pos < len(p.input) && p.input[p.pos] != '{' && p.input[p.pos] != ' ' {
+			p.pos++
+		}
+
+		selector := p.input[start:p.pos]
+		p.skipSpaces()
+
+		if strings.HasPrefix(selector, "offset:") {
+			continue
+		}
+
+		if p.pos >= len(p.input) || p.input[p.pos] != '{' {
+			return nil, fmt.Errorf("plural case %q without message at offset %d", selector, start)
+		}
+
+		p.pos++
+		nodes, err := p.message(arg)
+		if err != nil {
+			return nil, err
+		}
+
+		if p.pos >= len(p.input) {
+			return nil, fmt.Errorf("unterminated plural case %q", selector)
+		}
+
+		p.pos++
+		cases[selector] = nodes
+	}
+
+	if _, ok := cases["other"]; !ok {
+		return nil, fmt.Errorf("plural %s has no other case", arg)
+	}
+
+	return cases, nil
+}
+
+func (p *icuParser) skipSpaces() {
+	for p.pos < len(p.input) && strings.IndexByte(" \t\r\n", p.input[p.pos]) >= 0 {
+		p.pos++
+	}
+}
+
+func hasPlural(nodes []icuNode) bool {
+	for _, node := range nodes {
+		if node.plural != nil {
+			return true
+		}
+	}
+
+	return false
+}
+
+// pluralCases lists, for every form, the plural cases that select it in order of preference.
+var pluralCases = map[string][]string{
+	"singular": {"=1", "one", "other"},
+	"plural":   {"other"},
+	"none":     {"=0", "zero", "other"},
+}
+
+// renderICU renders nodes as a gotr template, picking the plural cases of form.
+func renderICU(nodes []icuNode, form string) string {
+	var b strings.Builder
+	for _, node := range nodes {
+		switch {
+		case node.plural != nil:
+			selectors := pluralCases[form]
+			if selectors == nil {
+				selectors = pluralCases["plural"]
+			}
+
+			for _, selector := range selectors {
+				if nodes, ok := node.plural[selector]; ok {
+					b.WriteString(renderICU(nodes, form))
+					break
+				}
+			}
+
+		case node.placeholder != "":
+			b.WriteString("{{." + node.placeholder + "}}")
+
+		default:
+			b.WriteString(node.text)
+		}
+	}
+
+	return b.String()
+}
+
+var templatePlaceholder = regexp.MustCompile(`\{\{\.([A-Za-z0-9_]+)\}\}`)
+
+// icuMessage converts a gotr template into ICU message syntax, quoting the characters ICU reserves.
+func icuMessage(text string, inPlural bool) string {
+	var b strings.Builder
+	last := 0
+
+	literal := func(s string, next byte) {
+		for i := 0; i < len(s); i++ {
+			c := s[i]
+			following := next
+			if i+1 < len(s) {
+				following = s[i+1]
+			}
+
+			switch {
+			case c == '\'' && isICUSyntax(following, inPlural):
+				b.WriteString("''")
+			case c != '\'' && isICUSyntax(c, inPlural):
+				// A run of syntax characters goes in a single quoted span, its apostrophes doubled, as
+				// adjacent spans would read as an escaped apostrophe.
+				b.WriteByte('\'')
+				for ; i < len(s) && isICUSyntax(s[i], inPlural); i++ {
+					if s[i] == '\'' {
+						b.WriteByte('\'')
+					}
+
+					b.WriteByte(s[i])
+				}
+				b.WriteByte('\'')
+				i--
+			default:
+				b.WriteByte(c)
+			}
+		}
+	}
+
+	for _, match := range templatePlaceholder.FindAllStringSubmatchIndex(text, -1) {
+		literal(text[last:match[0]], '{')
+		b.WriteString("{" + text[match[2]:match[3]] + "}")
+		last = match[1]
+	}
+
+	literal(text[last:], 0)
+	return b.String()
+}
+
+// EncodeARB writes the target texts of messages as a Flutter ARB file for locale. Plural messages
+// become {Count, plural, ...} messages, and the metadata and placeholders of every message are
+// declared in its @key attributes. Untranslated messages are skipped.
+func EncodeARB(w io.Writer, locale string, messages []Message) error {
+	arb := NewObject()
+	if locale != "" {
+		arb.put("@@locale", locale)
+	}
+
+	for _, m := range messages {
+		if m.Target.Empty() {
+			continue
+		}
+
+		names := placeholder.Names(m.Target.Singular)
+		value := icuMessage(m.Target.Singular, false)
+
+		if m.Plural {
+			names = placeholder.Names(m.Target.Singular + m.Target.Plural + m.Target.None)
+			if i := sort.SearchStrings(names, arbCount); i == len(names) || names[i] != arbCount {
+				names = append(names, arbCount)
+				sort.Strings(names)
+			}
+
+			value = fmt.Sprintf("{%s, plural, =0{%s} =1{%s} other{%s}}", arbCount,
+				icuMessage(m.Target.None, true), icuMessage(m.Target.Singular, true), icuMessage(m.Target.Plural, true))
+		}
+
+		arb.put(m.Key, value)
+
+		attributes := arbAttributes(m)
+		if len(names) > 0 {
+			placeholders := NewObject()
+			for _, name := range names {
+				declaration := NewObject()
+				if m.Plural && name == arbCount {
+					declaration.put("type", "int")
+				}
+
+				placeholders.put(name, declaration)
+			}
+
+			attributes.put("placeholders", placeholders)
+		}
+
+		if len(attributes.keys) > 0 {
+			arb.put("@"+m.Key, attributes)
+		}
+	}
+
+	return arb.Encode(w)
+}
+
+// arbAttributes returns the @key attributes of the metadata of m: its description and comment as the
+// description, its context, and its maximum length and tags as "x-" custom attributes.
+func arbAttributes(m Message) *Object {
+	attributes := NewObject()
+
+	description := m.Metadata.Description
+	if m.Metadata.Comment != "" {
+		description = strings.TrimSpace(description + "\n" + m.Metadata.Comment)
+	}
+
+	if description != "" {
+		attributes.put("description", description)
+	}
+
+	if m.Metadata.Context != "" {
+		attributes.put("context", m.Metadata.Context)
+	}
+
+	if m.Metadata.MaxLength > 0 {
+		attributes.put("x-maxLength", m.Metadata.MaxLength)
+	}
+
+	if len(m.Metadata.Tags) > 0 {
+		tags := make([]any, len(m.Metadata.Tags))
+		for i, tag := range m.Metadata.Tags {
+			tags[i] = tag
+		}
+
+		attributes.put("x-tags", tags)
+	}
+
+	return attributes
+}
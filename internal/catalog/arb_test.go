@@ -0,0 +1,156 @@
+package catalog
+
+import (
+	"bytes"
+	"os"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/leoviggiano/gotr/internal/metadata"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecodeARB(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		file, err := os.Open("testdata/app_pt.arb")
+		require.NoError(t, err)
+		defer file.Close()
+
+		tree, err := DecodeARB(file)
+		require.NoError(t, err)
+		require.Equal(t, map[string]any{
+			"hello_world": "Olá {{.name}}!",
+			"price":       "Custa {{.amount}}",
+			"texts":       map[string]any{"quote": "Use {chaves} e 'aspas'"},
+			"items": map[string]any{
+				"equipments": map[string]any{
+					"armor": map[string]any{
+						"singular": "{{.Name}} tem {{.count}} Armadura.",
+						"plural":   "{{.Name}} tem {{.count}} Armaduras.",
+						"none":     "{{.Name}} não tem Armadura.",
+					},
+				},
+			},
+		}, tree)
+	})
+
+	ttErrors := []struct {
+		name string
+		arb  string
+	}{
+		{name: "invalid json", arb: `{"a": `},
+		{name: "not a string", arb: `{"a": 1}`},
+		{name: "unterminated argument", arb: `{"a": "{name"}`},
+		{name: "empty argument", arb: `{"a": "{}"}`},
+		{name: "unexpected brace", arb: `{"a": "a}"}`},
+		{name: "plural without other", arb: `{"a": "{n, plural, one{a}}"}`},
+		{name: "plural case without message", arb: `{"a": "{n, plural, one other{a}}"}`},
+		{name: "select", arb: `{"a": "{g, select, male{he} other{they}}"}`},
+	}
+
+	for _, tc := range ttErrors {
+		t.Run(tc.name, func(t *testing.T) {
+			tree, err := DecodeARB(strings.NewReader(tc.arb))
+			require.ErrorIs(t, err, ErrInvalidARB)
+			require.Nil(t, tree)
+		})
+	}
+}
+
+func TestEncodeARB(t *testing.T) {
+	messages := []Message{
+		{Key: "hello_world", Target: Forms{Singular: "It's {{.Name}}'s {game}"}},
+		{Key: "untranslated", Source: Forms{Singular: "Untranslated"}},
+		{Key: "quote", Target: Forms{Singular: "'{{.Name}}'"}},
+		{
+			Key:      "texts.title",
+			Target:   Forms{Singular: "Inventory"},
+			Metadata: metadata.Metadata{Description: "Title of the inventory", Comment: "Shown in bold", MaxLength: 20, Context: "inventory", Tags: []string{"ui"}},
+		},
+		{
+			Key:    "items.armor",
+			Plural: true,
+			Target: Forms{Singular: "{{.Name}} has # {{.Count}} Armor", Plural: "{{.Name}} has {{.Count}} Armors", None: "{{.Name}} has no Armor"},
+		},
+	}
+
+	var buf bytes.Buffer
+	err := EncodeARB(&buf, "en", messages)
+	require.NoError(t, err)
+	require.Equal(t, `{
+    "@@locale": "en",
+    "hello_world": "It's {Name}'s '{'game'}'",
+    "@hello_world": {
+        "placeholders": {
+            "Name": {}
+        }
+    },
+    "quote": "''{Name}'",
+    "@quote": {
+        "placeholders": {
+            "Name": {}
+        }
+    },
+    "texts.title": "Inventory",
+    "@texts.title": {
+        "description": "Title of the inventory\nShown in bold",
+        "context": "inventory",
+        "x-maxLength": 20,
+        "x-tags": [
+            "ui"
+        ]
+    },
+    "items.armor": "{Count, plural, =0{{Name} has no Armor} =1{{Name} has '#' {Count} Armor} other{{Name} has {Count} Armors}}",
+    "@items.armor": {
+        "placeholders": {
+            "Count": {
+                "type": "int"
+            },
+            "Name": {}
+        }
+    }
+}
+`, buf.String())
+
+	tree, err := DecodeARB(&buf)
+	require.NoError(t, err)
+	require.Equal(t, map[string]any{
+		"hello_world": "It's {{.Name}}'s {game}",
+		"quote":       "'{{.Name}}'",
+		"texts":       map[string]any{"title": "Inventory"},
+		"items": map[string]any{
+			"armor": map[string]any{
+				"singular": "{{.Name}} has # {{.Count}} Armor",
+				"plural":   "{{.Name}} has {{.Count}} Armors",
+				"none":     "{{.Name}} has no Armor",
+			},
+		},
+	}, tree)
+
+	t.Run("round trip", func(t *testing.T) {
+		texts := []string{
+			"Use {} or }} here",
+			`Welcome to {{t "brand"}}, {{.Name}}!`,
+			"{'s '{ it's} '' {{.Name}}'",
+			"{{.Name}}}}{{{.Name}}",
+		}
+
+		messages := make([]Message, 0, len(texts))
+		expected := make(map[string]any, len(texts))
+		for i, text := range texts {
+			key := "text" + strconv.Itoa(i)
+			messages = append(messages, Message{Key: key, Target: Forms{Singular: text}})
+			expected[key] = text
+		}
+
+		var buf bytes.Buffer
+		err := EncodeARB(&buf, "en", messages)
+		require.NoError(t, err)
+		require.Contains(t, buf.String(), `"text0": "Use '{}' or '}}' here"`)
+
+		tree, err := DecodeARB(&buf)
+		require.NoError(t, err)
+		require.Equal(t, expected, tree)
+	})
+}
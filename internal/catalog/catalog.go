@@ -0,0 +1,282 @@
+package catalog
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/leoviggiano/gotr/internal/keypath"
+	"github.com/leoviggiano/gotr/internal/metadata"
+	"github.com/leoviggiano/gotr/internal/parser"
+	"github.com/leoviggiano/gotr/internal/scanner"
+)
+
+type Format string
+
+const (
+	JSON Format = "json"
+	YAML Format = "yaml"
+	PO   Format = "po"
+	MO   Format = "mo"
+
+	Android     Format = "android"
+	Strings     Format = "strings"
+	Stringsdict Format = "stringsdict"
+
+	ARB        Format = "arb"
+	Properties Format = "properties"
+)
+
+var (
+	ErrUnsupportedFormat = errors.New("unsupported format")
+	ErrPathConflict      = errors.New("path conflicts with an existing key")
+)
+
+// FormatOf detects the format of a translation file by its extension, defaulting to JSON.
+func FormatOf(path string) Format {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		return YAML
+	case ".po", ".pot":
+		return PO
+	case ".mo":
+		return MO
+	case ".xml":
+		return Android
+	case ".strings":
+		return Strings
+	case ".stringsdict":
+		return Stringsdict
+	case ".arb":
+		return ARB
+	case ".properties":
+		return Properties
+	default:
+		return JSON
+	}
+}
+
+// IsCatalog reports whether path has the extension of a supported translation file.
+func IsCatalog(path string) bool {
+	return strings.ToLower(filepath.Ext(path)) == ".json" || FormatOf(path) != JSON
+}
+
+// Load reads a translation file and decodes it into the key tree walked by the scanner and parser.
+func Load(path string) (map[string]any, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	return Decode(FormatOf(path), file)
+}
+
+// Decode decodes a translation catalog in the given format into its key tree.
+func Decode(format Format, r io.Reader) (map[string]any, error) {
+	switch format {
+	case JSON:
+		return DecodeJSON(r)
+	case YAML:
+		return DecodeYAML(r)
+	case PO:
+		return DecodePO(r)
+	case MO:
+		return DecodeMO(r)
+	case Android:
+		return DecodeAndroid(r)
+	case Strings:
+		return DecodeStrings(r)
+	case Stringsdict:
+		return DecodeStringsdict(r)
+	case ARB:
+		return DecodeARB(r)
+	case Properties:
+		return DecodeProperties(r)
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedFormat, format)
+	}
+}
+
+// DecodeJSON decodes a JSON catalog.
+func DecodeJSON(r io.Reader) (map[string]any, error) {
+	var v map[string]any
+	err := json.NewDecoder(r).Decode(&v)
+	if err != nil {
+		return nil, err
+	}
+
+	return v, nil
+}
+
+// SetPath stores value in tree at the dot separated path, creating the intermediate objects. Dots
+// escaped as "\." are part of a key.
+func SetPath(tree map[string]any, path string, value any) error {
+	segments := keypath.Split(path)
+	current := tree
+
+	for _, segment := range segments[:len(segments)-1] {
+		next, ok := current[segment]
+		if !ok {
+			child := make(map[string]any)
+			current[segment] = child
+			current = child
+			continue
+		}
+
+		child, ok := next.(map[string]any)
+		if !ok {
+			return fmt.Errorf("%w: %s", ErrPathConflict, path)
+		}
+
+		current = child
+	}
+
+	last := segments[len(segments)-1]
+	if existing, ok := current[last].(map[string]any); ok {
+		if _, isMap := value.(map[string]any); !isMap {
+			return fmt.Errorf("%w: %s", ErrPathConflict, path)
+		}
+
+		for k, v := range value.(map[string]any) {
+			existing[k] = v
+		}
+
+		return nil
+	}
+
+	current[last] = value
+	return nil
+}
+
+// PluralForms are the names of the plural forms of a template, as catalogs name them by default and
+// entries and exchange formats always do.
+var PluralForms = []string{"singular", "plural", "none"}
+
+// Entry is the raw content of a key path, before it becomes a template.
+type Entry struct {
+	Forms    map[string]string // Plural forms keyed by PluralForms, or the single value keyed by its own name
+	Plural   bool              // Whether the key holds plural forms
+	Metadata metadata.Metadata // Metadata of a message object
+}
+
+// Text returns the text used to look the entry up by its content.
+func (e Entry) Text() string {
+	if e.Plural {
+		return e.Forms["singular"]
+	}
+
+	for _, v := range e.Forms {
+		return v
+	}
+
+	return ""
+}
+
+// Texts returns the distinct non-empty texts of the forms of e, each of which looks the entry up.
+func (e Entry) Texts() []string {
+	if !e.Plural {
+		if text := e.Text(); text != "" {
+			return []string{text}
+		}
+
+		return nil
+	}
+
+	var texts []string
+	for _, form := range PluralForms {
+		if v := e.Forms[form]; v != "" && !slices.Contains(texts, v) {
+			texts = append(texts, v)
+		}
+	}
+
+	return texts
+}
+
+// ListPaths returns the key paths of the elements of the list at path, "path.0", "path.1" and so on
+// up to the first missing index, the way GetList reads them.
+func ListPaths(entries map[string]Entry, path string) []string {
+	var paths []string
+	for i := 0; ; i++ {
+		element := path + "." + strconv.Itoa(i)
+		if _, ok := entries[element]; !ok {
+			return paths
+		}
+
+		paths = append(paths, element)
+	}
+}
+
+// NormalizeWhitespace trims text and collapses its runs of whitespace into single spaces, the way
+// texts are looked up with normalized whitespace.
+func NormalizeWhitespace(text string) string {
+	return strings.Join(strings.Fields(text), " ")
+}
+
+// Entries walks the key tree with the scanner and parser and returns the raw entry of every key path,
+// reading the plural blocks of forms.
+func Entries(tree map[string]any, forms scanner.Forms) (map[string]Entry, error) {
+	result := make(map[string]Entry)
+
+	paths, err := scanner.Scan(tree, forms)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, path := range paths {
+		data, err := parser.Parse(tree, path)
+		if err != nil {
+			return nil, err
+		}
+
+		var values map[string]any
+		err = json.Unmarshal(data, &values)
+		if err != nil {
+			return nil, err
+		}
+
+		e := Entry{Forms: make(map[string]string)}
+		for _, form := range PluralForms {
+			if v, ok := values[forms.Name(form)]; ok {
+				e.Forms[form] = stringValue(v)
+			}
+		}
+
+		if len(e.Forms) > 0 {
+			// A message object with only a singular form is a plain text carrying metadata.
+			_, single := e.Forms["singular"]
+			e.Plural = !single || len(e.Forms) > 1
+
+			e.Metadata, err = metadata.Parse(values)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", path, err)
+			}
+		} else {
+			for k, v := range values {
+				e.Forms[k] = stringValue(v)
+			}
+		}
+
+		result[path] = e
+	}
+
+	return result, nil
+}
+
+func stringValue(v any) string {
+	switch v := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	default:
+		data, _ := json.Marshal(v)
+		return string(data)
+	}
+}
@@ -0,0 +1,106 @@
+package catalog
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/leoviggiano/gotr/internal/metadata"
+	"github.com/leoviggiano/gotr/internal/scanner"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoad(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tree, err := Load("../../translations/en_US.json")
+		require.NoError(t, err)
+		require.Equal(t, "Hello World", tree["hello_world"])
+	})
+
+	t.Run("error read file", func(t *testing.T) {
+		tree, err := Load("invalid")
+		require.Error(t, err)
+		require.Nil(t, tree)
+	})
+
+	t.Run("error unmarshal file", func(t *testing.T) {
+		tree, err := Load("./catalog_test.go")
+		require.Error(t, err)
+		require.Nil(t, tree)
+	})
+}
+
+func TestFormatOf(t *testing.T) {
+	tt := []struct {
+		path     string
+		expected Format
+	}{
+		{path: "en_US.json", expected: JSON},
+		{path: "en_US.yaml", expected: YAML},
+		{path: "en_US.YML", expected: YAML},
+		{path: "pt_BR.po", expected: PO},
+		{path: "pt_BR.mo", expected: MO},
+		{path: "values-pt/strings.xml", expected: Android},
+		{path: "Localizable.strings", expected: Strings},
+		{path: "Localizable.stringsdict", expected: Stringsdict},
+		{path: "app_pt.arb", expected: ARB},
+		{path: "messages_pt_BR.properties", expected: Properties},
+		{path: "en_US", expected: JSON},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.path, func(t *testing.T) {
+			require.Equal(t, tc.expected, FormatOf(tc.path))
+		})
+	}
+}
+
+func TestDecode(t *testing.T) {
+	t.Run("unsupported format", func(t *testing.T) {
+		tree, err := Decode(Format("xml"), strings.NewReader(""))
+		require.ErrorIs(t, err, ErrUnsupportedFormat)
+		require.Nil(t, tree)
+	})
+}
+
+func TestEntries(t *testing.T) {
+	tree := map[string]any{
+		"actions": map[string]any{"open": map[string]any{"singular": "Open", "context": "verb"}},
+		"items":   map[string]any{"armor": map[string]any{"singular": "Armor", "plural": "Armors"}},
+	}
+
+	entries, err := Entries(tree, scanner.DefaultForms)
+	require.NoError(t, err)
+
+	require.Equal(t, Entry{
+		Forms:    map[string]string{"singular": "Open"},
+		Metadata: metadata.Metadata{Context: "verb"},
+	}, entries["actions.open"])
+	require.Equal(t, Forms{Singular: "Open", Plural: "Open", None: "Open"}, EntryForms(entries["actions.open"]))
+	require.Equal(t, Entry{
+		Plural: true,
+		Forms:  map[string]string{"singular": "Armor", "plural": "Armors"},
+	}, entries["items.armor"])
+
+	t.Run("custom forms", func(t *testing.T) {
+		tree := map[string]any{"armor": map[string]any{"one": "Armor", "other": "Armors", "singular": "Shield"}}
+
+		entries, err := Entries(tree, scanner.Forms{Singular: "one", Plural: "other", None: "zero"})
+		require.NoError(t, err)
+		require.Equal(t, map[string]Entry{
+			"armor":          {Plural: true, Forms: map[string]string{"singular": "Armor", "plural": "Armors"}},
+			"armor.singular": {Forms: map[string]string{"singular": "Shield"}},
+		}, entries)
+	})
+}
+
+func TestListPaths(t *testing.T) {
+	tree := map[string]any{"tips": []any{"Rest", "Eat"}, "gaps": map[string]any{"0": "First", "2": "Third"}}
+
+	entries, err := Entries(tree, scanner.DefaultForms)
+	require.NoError(t, err)
+
+	require.Equal(t, []string{"tips.0", "tips.1"}, ListPaths(entries, "tips"))
+	require.Equal(t, []string{"gaps.0"}, ListPaths(entries, "gaps"))
+	require.Empty(t, ListPaths(entries, "tips.0"))
+	require.Empty(t, ListPaths(entries, "missing"))
+}
@@ -0,0 +1,81 @@
+package catalog
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+var ErrInvalidCSV = errors.New("invalid csv sheet")
+
+// csvKeyColumn is the header of the first column of a sheet, holding the key paths.
+const csvKeyColumn = "key"
+
+// Sheet is a table of texts with one column per locale and one row per key path, or per plural form
+// of a key path.
+type Sheet struct {
+	Locales []string
+	Rows    []SheetRow
+}
+
+// SheetRow holds the texts of a path in the order of Sheet.Locales.
+type SheetRow struct {
+	Path  string // Key path, followed by the plural form for plural messages
+	Texts []string
+}
+
+// EncodeCSV writes sheet as CSV, with a "key" column followed by one column per locale. Fields are
+// separated by comma, ',' for CSV and '\t' for TSV.
+func EncodeCSV(w io.Writer, sheet Sheet, comma rune) error {
+	cw := csv.NewWriter(w)
+	cw.Comma = comma
+	if err := cw.Write(append([]string{csvKeyColumn}, sheet.Locales...)); err != nil {
+		return err
+	}
+
+	for _, row := range sheet.Rows {
+		if err := cw.Write(append([]string{row.Path}, row.Texts...)); err != nil {
+			return err
+		}
+	}
+
+	cw.Flush()
+	return cw.Error()
+}
+
+// DecodeCSV reads a sheet written by EncodeCSV with the same comma, possibly edited in a spreadsheet.
+// Locales name files, so they can't hold path separators.
+func DecodeCSV(r io.Reader, comma rune) (Sheet, error) {
+	cr := csv.NewReader(r)
+	cr.Comma = comma
+	records, err := cr.ReadAll()
+	if err != nil {
+		return Sheet{}, fmt.Errorf("%w: %v", ErrInvalidCSV, err)
+	}
+
+	if len(records) == 0 || len(records[0]) < 2 || records[0][0] != csvKeyColumn {
+		return Sheet{}, fmt.Errorf("%w: header must be %q followed by the locales", ErrInvalidCSV, csvKeyColumn)
+	}
+
+	sheet := Sheet{Locales: records[0][1:]}
+	seen := make(map[string]struct{}, len(sheet.Locales))
+	for _, locale := range sheet.Locales {
+		if _, ok := seen[locale]; ok || locale == "" || locale == "." || locale == ".." || strings.ContainsAny(locale, `/\`) {
+			return Sheet{}, fmt.Errorf("%w: invalid or duplicated locale %q", ErrInvalidCSV, locale)
+		}
+
+		seen[locale] = struct{}{}
+	}
+
+	for i, record := range records[1:] {
+		if record[0] == "" {
+			return Sheet{}, fmt.Errorf("%w: row %d has no key", ErrInvalidCSV, i+2)
+		}
+
+		sheet.Rows = append(sheet.Rows, SheetRow{Path: record[0], Texts: record[1:]})
+	}
+
+	return sheet, nil
+}
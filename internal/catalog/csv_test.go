@@ -0,0 +1,64 @@
+package catalog
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCSV(t *testing.T) {
+	sheet := Sheet{
+		Locales: []string{"en", "pt"},
+		Rows: []SheetRow{
+			{Path: "hello_world", Texts: []string{"Hello, World", "Olá, Mundo"}},
+			{Path: "items.armor.singular", Texts: []string{`{{.Count}} "Armor"`, ""}},
+		},
+	}
+
+	var buf bytes.Buffer
+	err := EncodeCSV(&buf, sheet, ',')
+	require.NoError(t, err)
+	require.Equal(t, "key,en,pt\nhello_world,\"Hello, World\",\"Olá, Mundo\"\nitems.armor.singular,\"{{.Count}} \"\"Armor\"\"\",\n", buf.String())
+
+	decoded, err := DecodeCSV(&buf, ',')
+	require.NoError(t, err)
+	require.Equal(t, sheet, decoded)
+
+	t.Run("tsv", func(t *testing.T) {
+		var buf bytes.Buffer
+		err := EncodeCSV(&buf, sheet, '\t')
+		require.NoError(t, err)
+		require.Equal(t, "key\ten\tpt\nhello_world\tHello, World\tOlá, Mundo\nitems.armor.singular\t\"{{.Count}} \"\"Armor\"\"\"\t\n", buf.String())
+
+		decoded, err := DecodeCSV(&buf, '\t')
+		require.NoError(t, err)
+		require.Equal(t, sheet, decoded)
+	})
+}
+
+func TestDecodeCSV(t *testing.T) {
+	ttErrors := []struct {
+		name string
+		csv  string
+	}{
+		{name: "empty", csv: ""},
+		{name: "no key column", csv: "path,en\nhello_world,Hello\n"},
+		{name: "no locales", csv: "key\nhello_world\n"},
+		{name: "duplicated locale", csv: "key,en,en\nhello_world,Hello,Hi\n"},
+		{name: "empty locale", csv: "key,en,\nhello_world,Hello,Hi\n"},
+		{name: "locale with a path separator", csv: "key,en,../pt\nhello_world,Hello,Oi\n"},
+		{name: "locale with a windows path separator", csv: "key,en,..\\pt\nhello_world,Hello,Oi\n"},
+		{name: "parent directory locale", csv: "key,en,..\nhello_world,Hello,Oi\n"},
+		{name: "wrong number of fields", csv: "key,en,pt\nhello_world,Hello\n"},
+		{name: "row without key", csv: "key,en\n,Hello\n"},
+	}
+
+	for _, tc := range ttErrors {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := DecodeCSV(strings.NewReader(tc.csv), ',')
+			require.ErrorIs(t, err, ErrInvalidCSV)
+		})
+	}
+}
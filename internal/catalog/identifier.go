@@ -0,0 +1,29 @@
+package catalog
+
+import (
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// FilePattern captures a locale like "en", "en_US", "pt-BR" or "zh_Hant_TW" at the start of a file
+// name, ignoring any suffix after an underscore, so en_US_items is a file of en_US.
+var FilePattern = regexp.MustCompile(`^([a-z]{2,3}(?:[_-](?:[A-Z][a-z]{3}|[A-Z]{2}|[0-9]{3}))*)(?:_.*)?$`)
+
+// Identifier derives the identifier of the locale of a translation file from its name with pattern,
+// matched against the name without its directory and extension. The identifier is the "identifier"
+// group of pattern, or its first group.
+func Identifier(pattern *regexp.Regexp, name string) (string, bool) {
+	name = filepath.Base(name)
+	match := pattern.FindStringSubmatch(strings.TrimSuffix(name, filepath.Ext(name)))
+	if match == nil {
+		return "", false
+	}
+
+	identifier := match[1]
+	if i := pattern.SubexpIndex("identifier"); i > 0 {
+		identifier = match[i]
+	}
+
+	return identifier, identifier != ""
+}
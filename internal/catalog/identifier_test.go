@@ -0,0 +1,34 @@
+package catalog
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestIdentifier(t *testing.T) {
+	tt := []struct {
+		name       string
+		identifier string
+		ok         bool
+	}{
+		{name: "en_US.json", identifier: "en_US", ok: true},
+		{name: "translations/pt_BR_items.json", identifier: "pt_BR", ok: true},
+		{name: "notes.json", ok: false},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			identifier, ok := Identifier(FilePattern, tc.name)
+			require.Equal(t, tc.ok, ok)
+			require.Equal(t, tc.identifier, identifier)
+		})
+	}
+
+	t.Run("named group", func(t *testing.T) {
+		identifier, ok := Identifier(regexp.MustCompile(`^(app)\.(?P<identifier>\w+)$`), "app.fr.json")
+		require.True(t, ok)
+		require.Equal(t, "fr", identifier)
+	})
+}
@@ -0,0 +1,93 @@
+package catalog
+
+import (
+	"sort"
+
+	"github.com/leoviggiano/gotr/internal/metadata"
+)
+
+// Forms are the texts of a template for every count.
+type Forms struct {
+	Singular string
+	Plural   string
+	None     string
+}
+
+// Empty reports whether no form has a text.
+func (f Forms) Empty() bool {
+	return f.Singular == "" && f.Plural == "" && f.None == ""
+}
+
+// Get returns the text of the named plural form.
+func (f Forms) Get(form string) string {
+	switch form {
+	case "singular":
+		return f.Singular
+	case "plural":
+		return f.Plural
+	case "none":
+		return f.None
+	default:
+		return ""
+	}
+}
+
+// Message is a key path with its source text, in the default locale, and its translation, as
+// exchanged with translation tools.
+type Message struct {
+	Key      string
+	Plural   bool
+	Source   Forms
+	Target   Forms
+	Metadata metadata.Metadata
+}
+
+// EntryForms returns the texts of an entry, repeating its single value in every form.
+func EntryForms(e Entry) Forms {
+	if !e.Plural {
+		text := e.Text()
+		return Forms{Singular: text, Plural: text, None: text}
+	}
+
+	return Forms{Singular: e.Forms["singular"], Plural: e.Forms["plural"], None: e.Forms["none"]}
+}
+
+// Messages pairs every key path of source and target, sorted by key path. Keys only found in
+// target use their own text as source, and the metadata of target is used when source has none.
+func Messages(source, target map[string]Entry) []Message {
+	keys := make(map[string]struct{}, len(source))
+	for key := range source {
+		keys[key] = struct{}{}
+	}
+
+	for key := range target {
+		keys[key] = struct{}{}
+	}
+
+	messages := make([]Message, 0, len(keys))
+	for key := range keys {
+		s, hasSource := source[key]
+		t, hasTarget := target[key]
+
+		if !hasSource {
+			s = t
+		}
+
+		m := Message{Key: key, Plural: s.Plural || t.Plural, Source: EntryForms(s), Metadata: s.Metadata}
+		if m.Metadata.Empty() {
+			m.Metadata = t.Metadata
+		}
+
+		if hasTarget {
+			m.Target = EntryForms(t)
+		}
+
+		messages = append(messages, m)
+	}
+
+	sort.Slice(messages, func(i, j int) bool {
+		return messages[i].Key < messages[j].Key
+	})
+
+	return messages
+}
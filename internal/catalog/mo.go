@@ -0,0 +1,94 @@
+package catalog
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+var ErrInvalidMO = errors.New("invalid mo file")
+
+const (
+	moMagic        = 0x950412de
+	moContextSep   = "\x04"
+	moPluralSep    = "\x00"
+	moHeaderLength = 28
+)
+
+// DecodeMO decodes a compiled gettext MO catalog into the same key tree DecodePO builds.
+func DecodeMO(r io.Reader) (map[string]any, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(data) < moHeaderLength {
+		return nil, fmt.Errorf("%w: file too short", ErrInvalidMO)
+	}
+
+	var order binary.ByteOrder
+	switch {
+	case binary.LittleEndian.Uint32(data) == moMagic:
+		order = binary.LittleEndian
+	case binary.BigEndian.Uint32(data) == moMagic:
+		order = binary.BigEndian
+	default:
+		return nil, fmt.Errorf("%w: bad magic number", ErrInvalidMO)
+	}
+
+	count := order.Uint32(data[8:])
+	originals := order.Uint32(data[12:])
+	translations := order.Uint32(data[16:])
+
+	// Both tables must hold count entries of 8 bytes before count is trusted with an allocation.
+	for _, table := range []uint32{originals, translations} {
+		if uint64(table)+uint64(count)*8 > uint64(len(data)) {
+			return nil, fmt.Errorf("%w: string table out of range", ErrInvalidMO)
+		}
+	}
+
+	str := func(table uint32, i uint32) (string, error) {
+		pos := uint64(table) + uint64(i)*8
+		if pos+8 > uint64(len(data)) {
+			return "", fmt.Errorf("%w: string table out of range", ErrInvalidMO)
+		}
+
+		length := uint64(order.Uint32(data[pos:]))
+		offset := uint64(order.Uint32(data[pos+4:]))
+		if offset+length > uint64(len(data)) {
+			return "", fmt.Errorf("%w: string out of range", ErrInvalidMO)
+		}
+
+		return string(data[offset : offset+length]), nil
+	}
+
+	entries := make([]poEntry, 0, count)
+	for i := uint32(0); i < count; i++ {
+		original, err := str(originals, i)
+		if err != nil {
+			return nil, err
+		}
+
+		translation, err := str(translations, i)
+		if err != nil {
+			return nil, err
+		}
+
+		entry := poEntry{strs: make(map[int]string)}
+		if context, id, ok := strings.Cut(original, moContextSep); ok {
+			entry.context = context
+			original = id
+		}
+
+		entry.id, entry.idPlural, _ = strings.Cut(original, moPluralSep)
+		for idx, str := range strings.Split(translation, moPluralSep) {
+			entry.strs[idx] = str
+		}
+
+		entries = append(entries, entry)
+	}
+
+	return poTree(entries)
+}
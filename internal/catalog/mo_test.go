@@ -0,0 +1,81 @@
+package catalog
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// compileMO builds a MO file from original and translated strings, like msgfmt does.
+func compileMO(t *testing.T, order binary.ByteOrder, messages [][2]string) []byte {
+	t.Helper()
+
+	count := uint32(len(messages))
+	originals := uint32(moHeaderLength)
+	translations := originals + count*8
+	offset := translations + count*8
+
+	var header, tables, strs bytes.Buffer
+	for _, v := range []uint32{moMagic, 0, count, originals, translations, 0, 0} {
+		require.NoError(t, binary.Write(&header, order, v))
+	}
+
+	for column := 0; column < 2; column++ {
+		for _, m := range messages {
+			require.NoError(t, binary.Write(&tables, order, uint32(len(m[column]))))
+			require.NoError(t, binary.Write(&tables, order, offset+uint32(strs.Len())))
+			strs.WriteString(m[column] + "\x00")
+		}
+	}
+
+	return append(append(header.Bytes(), tables.Bytes()...), strs.Bytes()...)
+}
+
+func TestDecodeMO(t *testing.T) {
+	messages := [][2]string{
+		{"", "Language: pt_BR\nPlural-Forms: nplurals=2; plural=(n > 1);\nX-Generator: gotr\n"},
+		{"Hello World", "Olá Mundo"},
+		{"texts.welcome\x04Welcome!", "Bem-vindo!"},
+		{"items.armor\x04{{.Count}} Armor.\x00{{.Count}} Armors.", "{{.Count}} Armadura.\x00{{.Count}} Armaduras."},
+	}
+
+	expected := map[string]any{
+		"Hello World": "Olá Mundo",
+		"texts":       map[string]any{"welcome": "Bem-vindo!"},
+		"items": map[string]any{
+			"armor": map[string]any{"singular": "{{.Count}} Armadura.", "plural": "{{.Count}} Armaduras.", "none": "{{.Count}} Armadura."},
+		},
+	}
+
+	for name, order := range map[string]binary.ByteOrder{"little endian": binary.LittleEndian, "big endian": binary.BigEndian} {
+		t.Run(name, func(t *testing.T) {
+			tree, err := DecodeMO(bytes.NewReader(compileMO(t, order, messages)))
+			require.NoError(t, err)
+			require.Equal(t, expected, tree)
+		})
+	}
+
+	ttErrors := []struct {
+		name string
+		data []byte
+	}{
+		{name: "too short", data: []byte{0x95}},
+		{name: "bad magic", data: make([]byte, moHeaderLength)},
+		{name: "truncated", data: compileMO(t, binary.LittleEndian, messages)[:moHeaderLength+10]},
+		{name: "lying count", data: func() []byte {
+			data := compileMO(t, binary.LittleEndian, messages)[:moHeaderLength]
+			binary.LittleEndian.PutUint32(data[8:], 0xFFFFFFFF)
+			return data
+		}()},
+	}
+
+	for _, tc := range ttErrors {
+		t.Run(tc.name, func(t *testing.T) {
+			tree, err := DecodeMO(bytes.NewReader(tc.data))
+			require.ErrorIs(t, err, ErrInvalidMO)
+			require.Nil(t, tree)
+		})
+	}
+}
@@ -0,0 +1,326 @@
+package catalog
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/leoviggiano/gotr/internal/keypath"
+	"github.com/leoviggiano/gotr/internal/metadata"
+)
+
+var ErrInvalidObject = errors.New("invalid json object")
+
+// Object is a JSON object that keeps the order of its keys, so a catalog can be edited and written
+// back without reordering the keys nobody touched.
+type Object struct {
+	keys   []string
+	values map[string]any // string, json.Number, bool, nil, []any or *Object
+}
+
+// NewObject returns an empty Object.
+func NewObject() *Object {
+	return &Object{values: make(map[string]any)}
+}
+
+// DecodeObject decodes a JSON object keeping the order of its keys.
+func DecodeObject(r io.Reader) (*Object, error) {
+	dec := json.NewDecoder(r)
+	dec.UseNumber()
+
+	v, err := decodeOrdered(dec)
+	if err != nil {
+		return nil, err
+	}
+
+	obj, ok := v.(*Object)
+	if !ok {
+		return nil, fmt.Errorf("%w: not an object", ErrInvalidObject)
+	}
+
+	return obj, nil
+}
+
+// LoadObject reads the JSON catalog at path keeping its key order. A missing file is an empty object.
+func LoadObject(path string) (*Object, error) {
+	file, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return NewObject(), nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	return DecodeObject(file)
+}
+
+func decodeOrdered(dec *json.Decoder) (any, error) {
+	token, err := dec.Token()
+	if err != nil {
+		return nil, err
+	}
+
+	delim, ok := token.(json.Delim)
+	if !ok {
+		return token, nil
+	}
+
+	switch delim {
+	case '{':
+		obj := NewObject()
+		for dec.More() {
+			keyToken, err := dec.Token()
+			if err != nil {
+				return nil, err
+			}
+
+			value, err := decodeOrdered(dec)
+			if err != nil {
+				return nil, err
+			}
+
+			obj.put(keyToken.(string), value)
+		}
+
+		_, err := dec.Token()
+		return obj, err
+
+	case '[':
+		list := []any{}
+		for dec.More() {
+			value, err := decodeOrdered(dec)
+			if err != nil {
+				return nil, err
+			}
+
+			list = append(list, value)
+		}
+
+		_, err := dec.Token()
+		return list, err
+
+	default:
+		return nil, fmt.Errorf("%w: unexpected %v", ErrInvalidObject, delim)
+	}
+}
+
+// Keys returns the keys of the object in order.
+func (o *Object) Keys() []string {
+	return o.keys
+}
+
+// Value returns the value stored under key.
+func (o *Object) Value(key string) (any, bool) {
+	v, ok := o.values[key]
+	return v, ok
+}
+
+func (o *Object) put(key string, value any) {
+	if _, ok := o.values[key]; !ok {
+		o.keys = append(o.keys, key)
+	}
+
+	o.values[key] = value
+}
+
+// Set stores value at the dot separated path. Existing keys keep their position and new keys are
+// appended to their object; numeric segments index existing lists, and a path crossing any other
+// value replaces it with an object. A text stored at a message object holding a single text besides
+// its metadata replaces that text, keeping the metadata.
+func (o *Object) Set(path string, value any) {
+	segments := keypath.Split(path)
+	var current any = o
+
+	for i, segment := range segments[:len(segments)-1] {
+		child := element(current, segment)
+		if !holds(child, segments[i+1]) {
+			child = NewObject()
+			setElement(current, segment, child)
+		}
+
+		current = child
+	}
+
+	last := segments[len(segments)-1]
+	if message, ok := element(current, last).(*Object); ok {
+		if key, ok := message.textKey(); ok {
+			if _, ok := value.(string); ok {
+				message.put(key, value)
+				return
+			}
+		}
+	}
+
+	setElement(current, last, value)
+}
+
+// Depth returns how many leading segments of the dot separated path the object holds, so a catalog
+// holding the path, or most of it, can be told from one that doesn't.
+func (o *Object) Depth(path string) int {
+	segments := keypath.Split(path)
+	var current any = o
+
+	for i, segment := range segments {
+		if !contains(current, segment) {
+			return i
+		}
+
+		current = element(current, segment)
+	}
+
+	return len(segments)
+}
+
+// textKey returns the key of the only text of a message object besides its metadata keys.
+func (o *Object) textKey() (string, bool) {
+	var text string
+	for _, key := range o.keys {
+		if metadata.IsKey(key) {
+			continue
+		}
+
+		if _, ok := o.values[key].(string); !ok || text != "" {
+			return "", false
+		}
+
+		text = key
+	}
+
+	return text, text != ""
+}
+
+// holds reports whether the object or list container has room for segment.
+func holds(container any, segment string) bool {
+	switch c := container.(type) {
+	case *Object:
+		return true
+	case []any:
+		_, ok := keypath.Index(segment, len(c))
+		return ok
+	default:
+		return false
+	}
+}
+
+// contains reports whether the object or list container has a value under segment.
+func contains(container any, segment string) bool {
+	switch c := container.(type) {
+	case *Object:
+		_, ok := c.values[segment]
+		return ok
+	case []any:
+		_, ok := keypath.Index(segment, len(c))
+		return ok
+	default:
+		return false
+	}
+}
+
+// element returns the value of container under segment; container must hold segment.
+func element(container any, segment string) any {
+	switch c := container.(type) {
+	case *Object:
+		return c.values[segment]
+	case []any:
+		idx, _ := keypath.Index(segment, len(c))
+		return c[idx]
+	default:
+		return nil
+	}
+}
+
+// setElement stores value in container under segment; container must hold segment.
+func setElement(container any, segment string, value any) {
+	switch c := container.(type) {
+	case *Object:
+		c.put(segment, value)
+	case []any:
+		idx, _ := keypath.Index(segment, len(c))
+		c[idx] = value
+	}
+}
+
+// Encode writes the object as indented JSON, the way the catalogs in this repository are written.
+func (o *Object) Encode(w io.Writer) error {
+	var buf bytes.Buffer
+	if err := encodeOrdered(&buf, o, ""); err != nil {
+		return err
+	}
+
+	buf.WriteString("\n")
+	_, err := w.Write(buf.Bytes())
+	return err
+}
+
+const orderedIndent = "    "
+
+func encodeOrdered(buf *bytes.Buffer, v any, indent string) error {
+	switch v := v.(type) {
+	case *Object:
+		if len(v.keys) == 0 {
+			buf.WriteString("{}")
+			return nil
+		}
+
+		buf.WriteString("{\n")
+		for i, key := range v.keys {
+			buf.WriteString(indent + orderedIndent)
+			if err := encodeScalar(buf, key); err != nil {
+				return err
+			}
+
+			buf.WriteString(": ")
+			if err := encodeOrdered(buf, v.values[key], indent+orderedIndent); err != nil {
+				return err
+			}
+
+			if i < len(v.keys)-1 {
+				buf.WriteString(",")
+			}
+			buf.WriteString("\n")
+		}
+		buf.WriteString(indent + "}")
+
+	case []any:
+		if len(v) == 0 {
+			buf.WriteString("[]")
+			return nil
+		}
+
+		buf.WriteString("[\n")
+		for i, item := range v {
+			buf.WriteString(indent + orderedIndent)
+			if err := encodeOrdered(buf, item, indent+orderedIndent); err != nil {
+				return err
+			}
+
+			if i < len(v)-1 {
+				buf.WriteString(",")
+			}
+			buf.WriteString("\n")
+		}
+		buf.WriteString(indent + "]")
+
+	default:
+		return encodeScalar(buf, v)
+	}
+
+	return nil
+}
+
+func encodeScalar(buf *bytes.Buffer, v any) error {
+	var scalar bytes.Buffer
+	enc := json.NewEncoder(&scalar)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(v); err != nil {
+		return err
+	}
+
+	buf.Write(bytes.TrimSuffix(scalar.Bytes(), []byte("\n")))
+	return nil
+}
@@ -0,0 +1,164 @@
+package catalog
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestObject(t *testing.T) {
+	input := `{
+    "zeta": "Z",
+    "alpha": {
+        "count": 10,
+        "list": ["a", {"b": true}],
+        "empty": {}
+    },
+    "html": "<b>&</b>",
+    "null": null
+}
+`
+
+	t.Run("round trip", func(t *testing.T) {
+		obj, err := DecodeObject(strings.NewReader(input))
+		require.NoError(t, err)
+		require.Equal(t, []string{"zeta", "alpha", "html", "null"}, obj.Keys())
+
+		var buf bytes.Buffer
+		require.NoError(t, obj.Encode(&buf))
+		require.Equal(t, `{
+    "zeta": "Z",
+    "alpha": {
+        "count": 10,
+        "list": [
+            "a",
+            {
+                "b": true
+            }
+        ],
+        "empty": {}
+    },
+    "html": "<b>&</b>",
+    "null": null
+}
+`, buf.String())
+	})
+
+	t.Run("set", func(t *testing.T) {
+		obj, err := DecodeObject(strings.NewReader(`{"b": "B", "a": {"y": "Y", "x": "X"}, "c": "C"}`))
+		require.NoError(t, err)
+
+		obj.Set("a.x", "new X")
+		obj.Set("a.z", "Z")
+		obj.Set("c.plural", "Cs")
+		obj.Set("d.e", "E")
+
+		var buf bytes.Buffer
+		require.NoError(t, obj.Encode(&buf))
+		require.Equal(t, `{
+    "b": "B",
+    "a": {
+        "y": "Y",
+        "x": "new X",
+        "z": "Z"
+    },
+    "c": {
+        "plural": "Cs"
+    },
+    "d": {
+        "e": "E"
+    }
+}
+`, buf.String())
+
+		value, ok := obj.Value("b")
+		require.True(t, ok)
+		require.Equal(t, "B", value)
+	})
+
+	t.Run("set lists", func(t *testing.T) {
+		obj, err := DecodeObject(strings.NewReader(`{"tips": ["a", {"title": "b"}], "banners": ["c"]}`))
+		require.NoError(t, err)
+
+		obj.Set("tips.0", "new a")
+		obj.Set("tips.1.title", "new b")
+		obj.Set("banners.1", "d")
+
+		var buf bytes.Buffer
+		require.NoError(t, obj.Encode(&buf))
+		require.Equal(t, `{
+    "tips": [
+        "new a",
+        {
+            "title": "new b"
+        }
+    ],
+    "banners": {
+        "1": "d"
+    }
+}
+`, buf.String())
+	})
+
+	t.Run("depth", func(t *testing.T) {
+		obj, err := DecodeObject(strings.NewReader(input))
+		require.NoError(t, err)
+
+		require.Equal(t, 1, obj.Depth("zeta"))
+		require.Equal(t, 2, obj.Depth("alpha.count"))
+		require.Equal(t, 4, obj.Depth("alpha.list.1.b"))
+		require.Equal(t, 2, obj.Depth("alpha.list.2"))
+		require.Equal(t, 1, obj.Depth("zeta.missing"))
+		require.Equal(t, 0, obj.Depth("missing.key"))
+	})
+
+	t.Run("set message objects", func(t *testing.T) {
+		obj, err := DecodeObject(strings.NewReader(`{
+			"open": {"singular": "Abrir", "msgctxt": "verb", "description": "Menu action"},
+			"file": {"one": "Arquivo", "tags": ["menu"]},
+			"armor": {"singular": "Armadura", "plural": "Armaduras"}
+		}`))
+		require.NoError(t, err)
+
+		obj.Set("open", "Abre")
+		obj.Set("file", "Ficheiro")
+		obj.Set("armor", "Armadura")
+
+		var buf bytes.Buffer
+		require.NoError(t, obj.Encode(&buf))
+		require.Equal(t, `{
+    "open": {
+        "singular": "Abre",
+        "msgctxt": "verb",
+        "description": "Menu action"
+    },
+    "file": {
+        "one": "Ficheiro",
+        "tags": [
+            "menu"
+        ]
+    },
+    "armor": "Armadura"
+}
+`, buf.String())
+	})
+
+	ttErrors := []struct {
+		name  string
+		input string
+	}{
+		{name: "not an object", input: `["a"]`},
+		{name: "invalid json", input: `{"a": }`},
+		{name: "empty", input: ``},
+	}
+
+	for _, tc := range ttErrors {
+		t.Run(tc.name, func(t *testing.T) {
+			obj, err := DecodeObject(strings.NewReader(tc.input))
+			require.Error(t, err)
+			require.Nil(t, obj)
+		})
+	}
+}
@@ -0,0 +1,296 @@
+package catalog
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+var ErrInvalidPluralForms = errors.New("invalid plural forms")
+
+// DefaultPluralForms is the gettext Plural-Forms header assumed when a catalog doesn't declare one.
+const DefaultPluralForms = "nplurals=2; plural=(n != 1);"
+
+// PluralForms3 is the Plural-Forms header matching gotr's none, singular and plural forms.
+const PluralForms3 = "nplurals=3; plural=(n == 0 ? 0 : n == 1 ? 1 : 2);"
+
+// PluralRule is a parsed gettext Plural-Forms header.
+type PluralRule struct {
+	N    int             // Number of plural forms
+	eval func(n int) int // Plural form index for a count
+}
+
+// Index returns the plural form used for count n, clamped to the number of forms.
+func (r PluralRule) Index(n int) int {
+	idx := r.eval(n)
+	if idx < 0 || idx >= r.N {
+		return 0
+	}
+
+	return idx
+}
+
+// ParsePluralForms parses a header such as "nplurals=2; plural=(n != 1);".
+func ParsePluralForms(header string) (PluralRule, error) {
+	var (
+		rule PluralRule
+		expr string
+	)
+
+	for _, part := range strings.Split(header, ";") {
+		name, value, ok := strings.Cut(strings.TrimSpace(part), "=")
+		if !ok {
+			continue
+		}
+
+		switch strings.TrimSpace(name) {
+		case "nplurals":
+			n, err := strconv.Atoi(strings.TrimSpace(value))
+			if err != nil || n < 1 {
+				return PluralRule{}, fmt.Errorf("%w: nplurals: %s", ErrInvalidPluralForms, value)
+			}
+
+			rule.N = n
+		case "plural":
+			expr = value
+		}
+	}
+
+	if rule.N == 0 || expr == "" {
+		return PluralRule{}, fmt.Errorf("%w: %s", ErrInvalidPluralForms, header)
+	}
+
+	p := &pluralParser{input: expr}
+	eval, err := p.parse()
+	if err != nil {
+		return PluralRule{}, fmt.Errorf("%w: %v", ErrInvalidPluralForms, err)
+	}
+
+	rule.eval = eval
+	return rule, nil
+}
+
+// pluralParser is a recursive descent parser for the C expressions used in Plural-Forms.
+type pluralParser struct {
+	input string
+	pos   int
+}
+
+type pluralExpr func(n int) int
+
+func (p *pluralParser) parse() (pluralExpr, error) {
+	expr, err := p.ternary()
+	if err != nil {
+		return nil, err
+	}
+
+	p.skipSpaces()
+	if p.pos != len(p.input) {
+		return nil, fmt.Errorf("unexpected %q at %d", p.input[p.pos:], p.pos)
+	}
+
+	return expr, nil
+}
+
+func (p *pluralParser) ternary() (pluralExpr, error) {
+	cond, err := p.binary(0)
+	if err != nil {
+		return nil, err
+	}
+
+	if !p.consume("?") {
+		return cond, nil
+	}
+
+	then, err := p.ternary()
+	if err != nil {
+		return nil, err
+	}
+
+	if !p.consume(":") {
+		return nil, fmt.Errorf("expected ':' at %d", p.pos)
+	}
+
+	otherwise, err := p.ternary()
+	if err != nil {
+		return nil, err
+	}
+
+	return func(n int) int {
+		if cond(n) != 0 {
+			return then(n)
+		}
+
+		return otherwise(n)
+	}, nil
+}
+
+// binaryOperators are ordered by increasing precedence.
+var binaryOperators = [][]string{
+	{"||"},
+	{"&&"},
+	{"==", "!="},
+	{"<=", ">=", "<", ">"},
+	{"+", "-"},
+	{"*", "/", "%"},
+}
+
+func (p *pluralParser) binary(level int) (pluralExpr, error) {
+	if level == len(binaryOperators) {
+		return p.unary()
+	}
+
+	left, err := p.binary(level + 1)
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		op := ""
+		for _, candidate := range binaryOperators[level] {
+			if p.consume(candidate) {
+				op = candidate
+				break
+			}
+		}
+
+		if op == "" {
+			return left, nil
+		}
+
+		right, err := p.binary(level + 1)
+		if err != nil {
+			return nil, err
+		}
+
+		left = combine(op, left, right)
+	}
+}
+
+func combine(op string, left, right pluralExpr) pluralExpr {
+	boolean := func(b bool) int {
+		if b {
+			return 1
+		}
+
+		return 0
+	}
+
+	return func(n int) int {
+		a := left(n)
+		switch op {
+		case "||":
+			return boolean(a != 0 || right(n) != 0)
+		case "&&":
+			return boolean(a != 0 && right(n) != 0)
+		}
+
+		b := right(n)
+		switch op {
+		case "==":
+			return boolean(a == b)
+		case "!=":
+			return boolean(a != b)
+		case "<=":
+			return boolean(a <= b)
+		case ">=":
+			return boolean(a >= b)
+		case "<":
+			return boolean(a < b)
+		case ">":
+			return boolean(a > b)
+		case "+":
+			return a + b
+		case "-":
+			return a - b
+		case "*":
+			return a * b
+		case "/":
+			if b == 0 {
+				return 0
+			}
+
+			return a / b
+		default:
+			if b == 0 {
+				return 0
+			}
+
+			return a % b
+		}
+	}
+}
+
+func (p *pluralParser) unary() (pluralExpr, error) {
+	if p.consume("!") {
+		expr, err := p.unary()
+		if err != nil {
+			return nil, err
+		}
+
+		return func(n int) int {
+			if expr(n) == 0 {
+				return 1
+			}
+
+			return 0
+		}, nil
+	}
+
+	if p.consume("(") {
+		expr, err := p.ternary()
+		if err != nil {
+			return nil, err
+		}
+
+		if !p.consume(")") {
+			return nil, fmt.Errorf("expected ')' at %d", p.pos)
+		}
+
+		return expr, nil
+	}
+
+	if p.consume("n") {
+		return func(n int) int { return n }, nil
+	}
+
+	start := p.pos
+	for p.pos < len(p.input) && p.input[p.pos] >= '0' && p.input[p.pos] <= '9' {
+		p.pos++
+	}
+
+	if start == p.pos {
+		return nil, fmt.Errorf("unexpected %q at %d", p.input[p.pos:], p.pos)
+	}
+
+	value, err := strconv.Atoi(p.input[start:p.pos])
+	if err != nil {
+		return nil, err
+	}
+
+	return func(int) int { return value }, nil
+}
+
+func (p *pluralParser) skipSpaces() {
+	for p.pos < len(p.input) && strings.ContainsRune(" \t\r\n", rune(p.input[p.pos])) {
+		p.pos++
+	}
+}
+
+// consume skips the token if it is next in the input. A "<" or ">" isn't taken from "<=" or ">=",
+// and "!" isn't taken from "!=".
+func (p *pluralParser) consume(token string) bool {
+	p.skipSpaces()
+	if !strings.HasPrefix(p.input[p.pos:], token) {
+		return false
+	}
+
+	next := p.pos + len(token)
+	if (token == "<" || token == ">" || token == "!") && next < len(p.input) && p.input[next] == '=' {
+		return false
+	}
+
+	p.pos = next
+	return true
+}
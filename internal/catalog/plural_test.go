@@ -0,0 +1,57 @@
+package catalog
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParsePluralForms(t *testing.T) {
+	tt := []struct {
+		name     string
+		header   string
+		expected map[int]int
+	}{
+		{name: "default", header: DefaultPluralForms, expected: map[int]int{0: 1, 1: 0, 2: 1, 5: 1}},
+		{name: "gotr", header: PluralForms3, expected: map[int]int{0: 0, 1: 1, 2: 2, 5: 2}},
+		{name: "french", header: "nplurals=2; plural=(n > 1);", expected: map[int]int{0: 0, 1: 0, 2: 1}},
+		{name: "single form", header: "nplurals=1; plural=0;", expected: map[int]int{0: 0, 1: 0, 2: 0}},
+		{
+			name:     "russian",
+			header:   "nplurals=3; plural=(n%10==1 && n%100!=11 ? 0 : n%10>=2 && n%10<=4 && (n%100<10 || n%100>=20) ? 1 : 2);",
+			expected: map[int]int{0: 2, 1: 0, 2: 1, 5: 2, 11: 2, 21: 0, 22: 1},
+		},
+		{name: "negation", header: "nplurals=2; plural=!(n == 1);", expected: map[int]int{0: 1, 1: 0}},
+		{name: "out of range index", header: "nplurals=2; plural=n;", expected: map[int]int{0: 0, 1: 1, 2: 0}},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			rule, err := ParsePluralForms(tc.header)
+			require.NoError(t, err)
+
+			for n, idx := range tc.expected {
+				require.Equal(t, idx, rule.Index(n), "n = %d", n)
+			}
+		})
+	}
+
+	ttErrors := []struct {
+		name   string
+		header string
+	}{
+		{name: "empty", header: ""},
+		{name: "missing plural", header: "nplurals=2;"},
+		{name: "invalid nplurals", header: "nplurals=x; plural=0;"},
+		{name: "invalid expression", header: "nplurals=2; plural=(n != 1;"},
+		{name: "trailing tokens", header: "nplurals=2; plural=n 1;"},
+		{name: "incomplete ternary", header: "nplurals=2; plural=n ? 1;"},
+	}
+
+	for _, tc := range ttErrors {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := ParsePluralForms(tc.header)
+			require.ErrorIs(t, err, ErrInvalidPluralForms)
+		})
+	}
+}
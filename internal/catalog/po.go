@@ -0,0 +1,316 @@
+package catalog
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/leoviggiano/gotr/internal/keypath"
+	"github.com/leoviggiano/gotr/internal/metadata"
+)
+
+var ErrInvalidPO = errors.New("invalid po file")
+
+// poGenerator is the X-Generator header of the catalogs written by EncodePO, whose msgctxt is the
+// key path of each message.
+const poGenerator = "gotr"
+
+// poEntry is a single message of a gettext catalog.
+type poEntry struct {
+	context  string
+	id       string
+	idPlural string
+	strs     map[int]string
+	fuzzy    bool
+	comments []string // Extracted comments (#.), for translators
+}
+
+// DecodePO decodes a gettext PO catalog. Catalogs written by EncodePO store every message under its
+// msgctxt, the key path. Other catalogs store it under its msgid, with its msgctxt as metadata, and
+// a msgid found in several contexts under "msgctxt.msgid". Plural messages become singular, plural
+// and none forms by evaluating the Plural-Forms header for 1, 2 and 0. Fuzzy and untranslated
+// messages are skipped.
+func DecodePO(r io.Reader) (map[string]any, error) {
+	entries, err := parsePO(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return poTree(entries)
+}
+
+func parsePO(r io.Reader) ([]poEntry, error) {
+	var (
+		entries  []poEntry
+		current  poEntry
+		appendTo func(string)
+		line     int
+	)
+
+	started := func() bool {
+		return current.context != "" || current.id != "" || len(current.strs) > 0
+	}
+
+	flush := func() {
+		if started() {
+			entries = append(entries, current)
+		}
+
+		current = poEntry{strs: make(map[int]string)}
+		appendTo = nil
+	}
+	flush()
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+
+	for scanner.Scan() {
+		line++
+		text := strings.TrimSpace(scanner.Text())
+
+		if text == "" {
+			flush()
+			continue
+		}
+
+		if strings.HasPrefix(text, "#~") {
+			// Obsolete message.
+			continue
+		}
+
+		if strings.HasPrefix(text, `"`) {
+			if appendTo == nil {
+				return nil, fmt.Errorf("%w: line %d: unexpected string", ErrInvalidPO, line)
+			}
+
+			value, err := unquotePO(text)
+			if err != nil {
+				return nil, fmt.Errorf("%w: line %d: %v", ErrInvalidPO, line, err)
+			}
+
+			appendTo(value)
+			continue
+		}
+
+		keyword, rest, _ := strings.Cut(text, " ")
+
+		// A new message may start right after the previous msgstr without a blank line.
+		if len(current.strs) > 0 && (strings.HasPrefix(keyword, "#") || keyword == "msgctxt" || keyword == "msgid") {
+			flush()
+		}
+
+		if strings.HasPrefix(text, "#") {
+			switch {
+			case strings.HasPrefix(text, "#,"):
+				for _, flag := range strings.Split(text[2:], ",") {
+					if strings.TrimSpace(flag) == "fuzzy" {
+						current.fuzzy = true
+					}
+				}
+			case strings.HasPrefix(text, "#."):
+				current.comments = append(current.comments, strings.TrimSpace(text[2:]))
+			}
+
+			continue
+		}
+
+		value, err := unquotePO(strings.TrimSpace(rest))
+		if err != nil {
+			return nil, fmt.Errorf("%w: line %d: %v", ErrInvalidPO, line, err)
+		}
+
+		switch {
+		case keyword == "msgctxt":
+			current.context = value
+			appendTo = func(s string) { current.context += s }
+		case keyword == "msgid":
+			current.id = value
+			appendTo = func(s string) { current.id += s }
+		case keyword == "msgid_plural":
+			current.idPlural = value
+			appendTo = func(s string) { current.idPlural += s }
+		case keyword == "msgstr" || strings.HasPrefix(keyword, "msgstr[") && strings.HasSuffix(keyword, "]"):
+			idx := 0
+			if keyword != "msgstr" {
+				idx, err = strconv.Atoi(keyword[len("msgstr[") : len(keyword)-1])
+				if err != nil || idx < 0 {
+					return nil, fmt.Errorf("%w: line %d: invalid index %s", ErrInvalidPO, line, keyword)
+				}
+			}
+
+			current.strs[idx] = value
+			appendTo = func(s string) { current.strs[idx] += s }
+		default:
+			return nil, fmt.Errorf("%w: line %d: unknown keyword %q", ErrInvalidPO, line, keyword)
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	flush()
+	return entries, nil
+}
+
+// poTree builds the key tree of the translated messages, reading the plural rule from the header.
+func poTree(entries []poEntry) (map[string]any, error) {
+	rule, err := ParsePluralForms(DefaultPluralForms)
+	if err != nil {
+		return nil, err
+	}
+
+	keyPaths := false
+	for _, entry := range entries {
+		if entry.id != "" || entry.context != "" {
+			continue
+		}
+
+		for _, header := range strings.Split(entry.strs[0], "\n") {
+			name, value, ok := strings.Cut(header, ":")
+			switch {
+			case !ok:
+			case strings.EqualFold(strings.TrimSpace(name), "Plural-Forms"):
+				rule, err = ParsePluralForms(value)
+				if err != nil {
+					return nil, err
+				}
+			case strings.EqualFold(strings.TrimSpace(name), "X-Generator"):
+				keyPaths = strings.TrimSpace(value) == poGenerator
+			}
+		}
+	}
+
+	// Other tools give the same msgid several contexts, which then tell the keys apart.
+	contexts := make(map[string]int)
+	for _, entry := range entries {
+		contexts[entry.id]++
+	}
+
+	tree := make(map[string]any)
+	seen := make(map[string]bool)
+	for _, entry := range entries {
+		key := poKey(entry, keyPaths, contexts[entry.id] > 1)
+		if key == "" || entry.fuzzy {
+			continue
+		}
+
+		if seen[key] {
+			return nil, fmt.Errorf("%w: duplicate message %s", ErrInvalidPO, key)
+		}
+		seen[key] = true
+
+		var value any
+		if entry.idPlural == "" {
+			if entry.strs[0] == "" {
+				continue
+			}
+
+			value = entry.strs[0]
+			if !keyPaths && entry.context != "" {
+				value = map[string]any{"singular": entry.strs[0], metadata.MessageContext: entry.context}
+			}
+		} else {
+			forms := map[string]any{
+				"singular": entry.strs[rule.Index(1)],
+				"plural":   entry.strs[rule.Index(2)],
+				"none":     entry.strs[rule.Index(0)],
+			}
+
+			if forms["singular"] == "" && forms["plural"] == "" && forms["none"] == "" {
+				continue
+			}
+
+			if !keyPaths && entry.context != "" {
+				forms[metadata.MessageContext] = entry.context
+			}
+
+			value = forms
+		}
+
+		if err := SetPath(tree, key, value); err != nil {
+			return nil, err
+		}
+	}
+
+	return tree, nil
+}
+
+// poKey returns the key path of a message. In catalogs written by EncodePO it is the msgctxt, or
+// the msgid when there is no context. Otherwise it is the msgid, prefixed with the msgctxt when the
+// msgid has several contexts. The msgid is a text, not a path, so its dots are part of the key.
+func poKey(entry poEntry, keyPaths, sharedID bool) string {
+	switch {
+	case keyPaths && entry.context != "":
+		return entry.context
+	case sharedID && entry.context != "":
+		return entry.context + "." + keypath.Escape(entry.id)
+	default:
+		return keypath.Escape(entry.id)
+	}
+}
+
+func unquotePO(text string) (string, error) {
+	if len(text) < 2 || text[0] != '"' || text[len(text)-1] != '"' {
+		return "", fmt.Errorf("expected quoted string: %s", text)
+	}
+
+	return strconv.Unquote(text)
+}
+
+// EncodePO writes messages as a gettext PO catalog for language. The key path goes into msgctxt,
+// the source text into msgid and msgid_plural, and plural translations into msgstr[0] (none),
+// msgstr[1] (singular) and msgstr[2] (plural), as declared by the PluralForms3 header. The metadata
+// of a message is written as extracted comments, and an X-Generator header tells DecodePO to read
+// each msgctxt back as a key path.
+func EncodePO(w io.Writer, language string, messages []Message) error {
+	b := &poWriter{w: w}
+
+	b.line(`msgid ""`)
+	b.line(`msgstr ""`)
+	b.line(strconv.Quote("Content-Type: text/plain; charset=UTF-8\n"))
+	b.line(strconv.Quote("Language: " + language + "\n"))
+	b.line(strconv.Quote("Plural-Forms: " + PluralForms3 + "\n"))
+	b.line(strconv.Quote("X-Generator: " + poGenerator + "\n"))
+
+	for _, m := range messages {
+		b.line("")
+
+		for _, note := range m.Metadata.Notes() {
+			b.line("#. " + strings.ReplaceAll(note, "\n", "\\n"))
+		}
+
+		if m.Plural {
+			b.line("#. none: " + strings.ReplaceAll(m.Source.None, "\n", "\\n"))
+		}
+
+		b.line("msgctxt " + strconv.Quote(m.Key))
+		b.line("msgid " + strconv.Quote(m.Source.Singular))
+
+		if !m.Plural {
+			b.line("msgstr " + strconv.Quote(m.Target.Singular))
+			continue
+		}
+
+		b.line("msgid_plural " + strconv.Quote(m.Source.Plural))
+		b.line("msgstr[0] " + strconv.Quote(m.Target.None))
+		b.line("msgstr[1] " + strconv.Quote(m.Target.Singular))
+		b.line("msgstr[2] " + strconv.Quote(m.Target.Plural))
+	}
+
+	return b.err
+}
+
+type poWriter struct {
+	w   io.Writer
+	err error
+}
+
+func (b *poWriter) line(s string) {
+	if b.err == nil {
+		_, b.err = io.WriteString(b.w, s+"\n")
+	}
+}
@@ -0,0 +1,164 @@
+package catalog
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/leoviggiano/gotr/internal/keypath"
+	"github.com/leoviggiano/gotr/internal/metadata"
+	"github.com/leoviggiano/gotr/internal/scanner"
+	"github.com/stretchr/testify/require"
+)
+
+const gotrHeader = "msgid \"\"\nmsgstr \"X-Generator: gotr\\n\"\n\n"
+
+func TestDecodePO(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tree, err := Load("./testdata/pt_BR.po")
+		require.NoError(t, err)
+
+		require.Equal(t, map[string]any{
+			"Hello World": "Olá Mundo",
+			"texts": map[string]any{
+				"welcome": "Bem-vindo ao meu jogo!",
+			},
+			"items": map[string]any{
+				"equipments": map[string]any{
+					"armor": map[string]any{
+						"singular": "{{.Name}} tem {{.Count}} Armadura.",
+						"plural":   "{{.Name}} tem {{.Count}} Armaduras.",
+						"none":     "{{.Name}} tem {{.Count}} Armadura.",
+					},
+				},
+			},
+		}, tree)
+	})
+
+	t.Run("default plural forms", func(t *testing.T) {
+		po := "msgid \"apple\"\nmsgid_plural \"apples\"\nmsgstr[0] \"maçã\"\nmsgstr[1] \"maçãs\"\n"
+
+		tree, err := DecodePO(strings.NewReader(po))
+		require.NoError(t, err)
+		require.Equal(t, map[string]any{
+			"apple": map[string]any{"singular": "maçã", "plural": "maçãs", "none": "maçãs"},
+		}, tree)
+	})
+
+	t.Run("messages without blank lines", func(t *testing.T) {
+		po := "msgid \"a\"\nmsgstr \"A\"\nmsgid \"b\"\nmsgstr \"B\"\n"
+
+		tree, err := DecodePO(strings.NewReader(po))
+		require.NoError(t, err)
+		require.Equal(t, map[string]any{"a": "A", "b": "B"}, tree)
+	})
+
+	t.Run("dotted msgid", func(t *testing.T) {
+		po := "msgid \"Loading...\"\nmsgstr \"Carregando...\"\n"
+
+		tree, err := DecodePO(strings.NewReader(po))
+		require.NoError(t, err)
+		require.Equal(t, map[string]any{"Loading...": "Carregando..."}, tree)
+	})
+
+	t.Run("msgctxt", func(t *testing.T) {
+		po := "msgctxt \"menu\"\nmsgid \"Open\"\nmsgstr \"Abrir\"\n\n" +
+			"msgctxt \"menu\"\nmsgid \"Close...\"\nmsgstr \"Fechar...\"\n\n" +
+			"msgctxt \"title\"\nmsgid \"Open\"\nmsgstr \"Aberto\"\n\n" +
+			"msgctxt \"title\"\nmsgid \"apple\"\nmsgid_plural \"apples\"\nmsgstr[0] \"maçã\"\nmsgstr[1] \"maçãs\"\n"
+
+		tree, err := DecodePO(strings.NewReader(po))
+		require.NoError(t, err)
+		require.Equal(t, map[string]any{
+			"menu":     map[string]any{"Open": map[string]any{"singular": "Abrir", "msgctxt": "menu"}},
+			"title":    map[string]any{"Open": map[string]any{"singular": "Aberto", "msgctxt": "title"}},
+			"Close...": map[string]any{"singular": "Fechar...", "msgctxt": "menu"},
+			"apple":    map[string]any{"singular": "maçã", "plural": "maçãs", "none": "maçãs", "msgctxt": "title"},
+		}, tree)
+
+		entries, err := Entries(tree, scanner.DefaultForms)
+		require.NoError(t, err)
+		require.Equal(t, Entry{
+			Forms:    map[string]string{"singular": "Fechar..."},
+			Metadata: metadata.Metadata{MessageContext: "menu"},
+		}, entries[keypath.Escape("Close...")])
+	})
+
+	ttErrors := []struct {
+		name string
+		po   string
+		err  error
+	}{
+		{name: "unknown keyword", po: "msgfoo \"a\"\n", err: ErrInvalidPO},
+		{name: "unquoted string", po: "msgid a\n", err: ErrInvalidPO},
+		{name: "unexpected string", po: "\"a\"\n", err: ErrInvalidPO},
+		{name: "invalid index", po: "msgid \"a\"\nmsgstr[x] \"A\"\n", err: ErrInvalidPO},
+		{name: "invalid plural forms", po: "msgid \"\"\nmsgstr \"Plural-Forms: nplurals=2;\\n\"\n", err: ErrInvalidPluralForms},
+		{name: "duplicate msgid", po: "msgid \"a\"\nmsgstr \"A\"\n\nmsgid \"a\"\nmsgstr \"B\"\n", err: ErrInvalidPO},
+		{name: "duplicate msgctxt and msgid", po: "msgctxt \"a\"\nmsgid \"A\"\nmsgstr \"A\"\n\nmsgctxt \"a\"\nmsgid \"A\"\nmsgstr \"B\"\n", err: ErrInvalidPO},
+		{name: "path conflict", po: gotrHeader + "msgctxt \"a\"\nmsgid \"A\"\nmsgstr \"A\"\n\nmsgctxt \"a.b\"\nmsgid \"B\"\nmsgstr \"B\"\n", err: ErrPathConflict},
+	}
+
+	for _, tc := range ttErrors {
+		t.Run(tc.name, func(t *testing.T) {
+			tree, err := DecodePO(strings.NewReader(tc.po))
+			require.ErrorIs(t, err, tc.err)
+			require.Nil(t, tree)
+		})
+	}
+}
+
+func TestEncodePO(t *testing.T) {
+	messages := []Message{
+		{
+			Key:    "hello_world",
+			Source: Forms{Singular: "Hello World", Plural: "Hello World", None: "Hello World"},
+			Target: Forms{Singular: "Olá Mundo", Plural: "Olá Mundo", None: "Olá Mundo"},
+		},
+		{
+			Key:      "items.equipments.armor",
+			Plural:   true,
+			Source:   Forms{Singular: "{{.Count}} Armor.", Plural: "{{.Count}} Armors.", None: "No Armor."},
+			Target:   Forms{Singular: "{{.Count}} Armadura.", Plural: "{{.Count}} Armaduras.", None: "Sem \"Armadura\"."},
+			Metadata: metadata.Metadata{Description: "Armor of the inventory", MaxLength: 20},
+		},
+	}
+
+	var buf bytes.Buffer
+	err := EncodePO(&buf, "pt", messages)
+	require.NoError(t, err)
+
+	expected := `msgid ""
+msgstr ""
+"Content-Type: text/plain; charset=UTF-8\n"
+"Language: pt\n"
+"Plural-Forms: nplurals=3; plural=(n == 0 ? 0 : n == 1 ? 1 : 2);\n"
+"X-Generator: gotr\n"
+
+msgctxt "hello_world"
+msgid "Hello World"
+msgstr "Olá Mundo"
+
+#. Armor of the inventory
+#. Maximum length: 20
+#. none: No Armor.
+msgctxt "items.equipments.armor"
+msgid "{{.Count}} Armor."
+msgid_plural "{{.Count}} Armors."
+msgstr[0] "Sem \"Armadura\"."
+msgstr[1] "{{.Count}} Armadura."
+msgstr[2] "{{.Count}} Armaduras."
+`
+	require.Equal(t, expected, buf.String())
+
+	tree, err := DecodePO(&buf)
+	require.NoError(t, err)
+	require.Equal(t, map[string]any{
+		"hello_world": "Olá Mundo",
+		"items": map[string]any{
+			"equipments": map[string]any{
+				"armor": map[string]any{"singular": "{{.Count}} Armadura.", "plural": "{{.Count}} Armaduras.", "none": "Sem \"Armadura\"."},
+			},
+		},
+	}, tree)
+}
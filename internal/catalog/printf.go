@@ -0,0 +1,87 @@
+package catalog
+
+import (
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/leoviggiano/gotr/internal/placeholder"
+)
+
+// countArgument is the placeholder holding the quantity plural forms are selected with.
+const countArgument = "Count"
+
+// argumentsNote starts the note naming the printf arguments of a message, in order.
+const argumentsNote = "Arguments: "
+
+// printfVerb matches the specifiers of Android and Apple format strings, like %s, %d, %@ or the
+// positional %1$s, and the escaped percent sign %%.
+var printfVerb = regexp.MustCompile(`%%|%(?:([0-9]+)\$)?(?:ll|l|hh|h|q|z)?[@dDiuUxXoOsScCfeEgG]`)
+
+// printfArguments returns the placeholder names of forms in the order of their printf arguments:
+// Count first, when forms use it or count is set, and then the others sorted.
+func printfArguments(forms Forms, count bool) []string {
+	names := placeholder.Names(forms.Singular + forms.Plural + forms.None)
+	if i := slices.Index(names, countArgument); i >= 0 {
+		names = slices.Delete(names, i, i+1)
+		count = true
+	}
+
+	if count {
+		names = append([]string{countArgument}, names...)
+	}
+
+	return names
+}
+
+// toPrintf escapes the percent signs of text and replaces its placeholders with the positional
+// specifiers of args: %N$d for Count and %N$ followed by verb for the others.
+func toPrintf(text string, args []string, verb string) string {
+	text = strings.ReplaceAll(text, "%", "%%")
+	for i, name := range args {
+		v := verb
+		if name == countArgument {
+			v = "d"
+		}
+
+		text = strings.ReplaceAll(text, "{{."+name+"}}", "%"+strconv.Itoa(i+1)+"$"+v)
+	}
+
+	return text
+}
+
+// fromPrintf replaces the specifiers of text with the placeholders args names by position, or
+// {{.ArgN}} when args has no name for the Nth argument, and unescapes its percent signs.
+func fromPrintf(text string, args []string) string {
+	next := 0
+	return printfVerb.ReplaceAllStringFunc(text, func(spec string) string {
+		if spec == "%%" {
+			return "%"
+		}
+
+		n := next
+		if position := printfVerb.FindStringSubmatch(spec)[1]; position != "" {
+			n, _ = strconv.Atoi(position)
+			n--
+		} else {
+			next++
+		}
+
+		if n >= 0 && n < len(args) && args[n] != "" {
+			return "{{." + args[n] + "}}"
+		}
+
+		return "{{.Arg" + strconv.Itoa(n+1) + "}}"
+	})
+}
+
+// parseArguments reads the argument names of an arguments note, or returns nil for another note.
+func parseArguments(note string) []string {
+	names, ok := strings.CutPrefix(strings.TrimSpace(note), argumentsNote)
+	if !ok {
+		return nil
+	}
+
+	return strings.Split(names, ", ")
+}
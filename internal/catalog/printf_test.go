@@ -0,0 +1,58 @@
+package catalog
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPrintfArguments(t *testing.T) {
+	tt := []struct {
+		name     string
+		forms    Forms
+		count    bool
+		expected []string
+	}{
+		{name: "none", forms: Forms{Singular: "Hello"}, expected: []string{}},
+		{name: "count first", forms: Forms{Singular: "{{.Name}} has {{.Count}}", None: "{{.Other}}"}, expected: []string{"Count", "Name", "Other"}},
+		{name: "count forced", forms: Forms{Singular: "{{.Name}}"}, count: true, expected: []string{"Count", "Name"}},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, printfArguments(tc.forms, tc.count))
+		})
+	}
+}
+
+func TestPrintf(t *testing.T) {
+	args := []string{"Count", "Name"}
+
+	tt := []struct {
+		name   string
+		text   string
+		printf string
+	}{
+		{name: "plain", text: "Hello", printf: "Hello"},
+		{name: "placeholders", text: "{{.Name}} has {{.Count}}", printf: "%2$@ has %1$d"},
+		{name: "percent signs", text: "100% {{.Name}} %d", printf: "100%% %2$@ %%d"},
+		{name: "unknown placeholder", text: "{{.Other}}", printf: "{{.Other}}"},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.printf, toPrintf(tc.text, args, "@"))
+			require.Equal(t, tc.text, fromPrintf(tc.printf, args))
+		})
+	}
+
+	t.Run("unnamed arguments", func(t *testing.T) {
+		require.Equal(t, "{{.Count}} {{.Name}} {{.Arg3}} {{.Name}}", fromPrintf("%d %@ %lu %2$s", args))
+		require.Equal(t, "{{.Arg1}}", fromPrintf("%1$s", nil))
+	})
+}
+
+func TestParseArguments(t *testing.T) {
+	require.Equal(t, []string{"Count", "Name"}, parseArguments(" Arguments: Count, Name "))
+	require.Nil(t, parseArguments("Home screen"))
+}
@@ -0,0 +1,250 @@
+package catalog
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"unicode/utf16"
+	"unicode/utf8"
+
+	"github.com/leoviggiano/gotr/internal/keypath"
+)
+
+var ErrInvalidProperties = errors.New("invalid properties file")
+
+// DecodeProperties decodes a Java .properties file, in UTF-8 or ISO-8859-1, storing every value at
+// the path its dotted key describes, see propertiesPath for keys extending other keys.
+func DecodeProperties(r io.Reader) (map[string]any, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	if !utf8.Valid(data) {
+		runes := make([]rune, len(data))
+		for i, b := range data {
+			runes[i] = rune(b)
+		}
+
+		data = []byte(string(runes))
+	}
+
+	var keys, values []string
+	for _, line := range propertiesLines(data) {
+		key, value := splitProperty(line)
+
+		key, err := unescapeProperties(key)
+		if err != nil {
+			return nil, err
+		}
+
+		value, err = unescapeProperties(value)
+		if err != nil {
+			return nil, err
+		}
+
+		keys = append(keys, key)
+		values = append(values, value)
+	}
+
+	defined := make(map[string]bool, len(keys))
+	for _, key := range keys {
+		defined[key] = true
+	}
+
+	tree := make(map[string]any)
+	for i, key := range keys {
+		if err := SetPath(tree, propertiesPath(key, defined), values[i]); err != nil {
+			return nil, err
+		}
+	}
+
+	return tree, nil
+}
+
+// propertiesPath returns the key path of a dotted key. A key extending another key of the file, like
+// "error.notfound" next to "error", can't be below a text, so it keeps its dots from the segment of
+// that key on: its path is "error\.notfound".
+func propertiesPath(key string, defined map[string]bool) string {
+	segments := strings.Split(key, ".")
+	for n := 1; n < len(segments); n++ {
+		if defined[strings.Join(segments[:n], ".")] {
+			return keypath.Join(append(segments[:n-1:n-1], strings.Join(segments[n-1:], "."))...)
+		}
+	}
+
+	return keypath.Join(segments...)
+}
+
+// propertiesLines returns the logical lines of a properties file, joining the lines ended by a
+// backslash and dropping blank lines and comments.
+func propertiesLines(data []byte) []string {
+	var lines []string
+	var logical strings.Builder
+	continued := false
+
+	scanner := bufio.NewScanner(bytes.NewReader(bytes.ReplaceAll(data, []byte("\r\n"), []byte("\n"))))
+	for scanner.Scan() {
+		line := strings.TrimLeft(scanner.Text(), " \t\f")
+		if !continued && (line == "" || line[0] == '#' || line[0] == '!') {
+			continue
+		}
+
+		trailing := len(line) - len(strings.TrimRight(line, `\`))
+		continued = trailing%2 == 1
+		if continued {
+			line = line[:len(line)-1]
+		}
+
+		logical.WriteString(line)
+		if !continued {
+			lines = append(lines, logical.String())
+			logical.Reset()
+		}
+	}
+
+	if logical.Len() > 0 {
+		lines = append(lines, logical.String())
+	}
+
+	return lines
+}
+
+// splitProperty splits a logical line at the first unescaped '=', ':' or whitespace.
+func splitProperty(line string) (string, string) {
+	for i := 0; i < len(line); i++ {
+		switch line[i] {
+		case '\\':
+			i++
+		case '=', ':', ' ', '\t', '\f':
+			rest := strings.TrimLeft(line[i:], " \t\f")
+			if rest != "" && (rest[0] == '=' || rest[0] == ':') && (line[i] == ' ' || line[i] == '\t' || line[i] == '\f') {
+				rest = rest[1:]
+			} else if line[i] == '=' || line[i] == ':' {
+				rest = line[i+1:]
+			}
+
+			return line[:i], strings.TrimLeft(rest, " \t\f")
+		}
+	}
+
+	return line, ""
+}
+
+func unescapeProperties(s string) (string, error) {
+	if !strings.Contains(s, `\`) {
+		return s, nil
+	}
+
+	var runes []rune
+	for i := 0; i < len(s); i++ {
+		r, size := utf8.DecodeRuneInString(s[i:])
+		if r != '\\' || i+1 == len(s) {
+			runes = append(runes, r)
+			i += size - 1
+			continue
+		}
+
+		i++
+		switch s[i] {
+		case 'n':
+			runes = append(runes, '\n')
+		case 't':
+			runes = append(runes, '\t')
+		case 'r':
+			runes = append(runes, '\r')
+		case 'f':
+			runes = append(runes, '\f')
+		case 'u':
+			if i+4 >= len(s) {
+				return "", fmt.Errorf("%w: invalid unicode escape in %q", ErrInvalidProperties, s)
+			}
+
+			code, err := strconv.ParseUint(s[i+1:i+5], 16, 16)
+			if err != nil {
+				return "", fmt.Errorf("%w: invalid unicode escape in %q", ErrInvalidProperties, s)
+			}
+
+			runes = append(runes, rune(code))
+			i += 4
+		default:
+			r, size := utf8.DecodeRuneInString(s[i:])
+			runes = append(runes, r)
+			i += size - 1
+		}
+	}
+
+	// Characters outside the BMP are escaped as surrogate pairs.
+	for i := 0; i+1 < len(runes); i++ {
+		if r := utf16.DecodeRune(runes[i], runes[i+1]); r != utf8.RuneError {
+			runes = append(runes[:i], append([]rune{r}, runes[i+2:]...)...)
+		}
+	}
+
+	return string(runes), nil
+}
+
+// EncodeProperties writes the target texts of messages as a Java .properties file, with the forms of
+// plural messages under "key.singular", "key.plural" and "key.none" and escaped dots written as plain
+// dots. Characters outside ASCII are written as \uXXXX escapes, so the file reads the same as UTF-8 and
+// ISO-8859-1. Untranslated messages are skipped.
+func EncodeProperties(w io.Writer, messages []Message) error {
+	var b bytes.Buffer
+	property := func(key, value string) {
+		b.WriteString(escapeProperties(key, true) + " = " + escapeProperties(value, false) + "\n")
+	}
+
+	for _, m := range messages {
+		if m.Target.Empty() {
+			continue
+		}
+
+		// Java keys are plain dotted names.
+		key := strings.Join(keypath.Split(m.Key), ".")
+		if !m.Plural {
+			property(key, m.Target.Singular)
+			continue
+		}
+
+		property(key+".singular", m.Target.Singular)
+		property(key+".plural", m.Target.Plural)
+		property(key+".none", m.Target.None)
+	}
+
+	_, err := w.Write(b.Bytes())
+	return err
+}
+
+func escapeProperties(s string, key bool) string {
+	var b strings.Builder
+	for i, r := range s {
+		switch {
+		case r == '\\':
+			b.WriteString(`\\`)
+		case r == '\n':
+			b.WriteString(`\n`)
+		case r == '\t':
+			b.WriteString(`\t`)
+		case r == '\r':
+			b.WriteString(`\r`)
+		case r == '\f':
+			b.WriteString(`\f`)
+		case r == ' ' && (key || i == 0):
+			b.WriteString(`\ `)
+		case key && strings.ContainsRune("=:#!", r):
+			b.WriteString(`\` + string(r))
+		case r > 0x7e:
+			for _, unit := range utf16.Encode([]rune{r}) {
+				fmt.Fprintf(&b, `\u%04x`, unit)
+			}
+		default:
+			b.WriteRune(r)
+		}
+	}
+
+	return b.String()
+}
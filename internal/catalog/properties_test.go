@@ -0,0 +1,102 @@
+package catalog
+
+import (
+	"bytes"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecodeProperties(t *testing.T) {
+	expected := map[string]any{
+		"hello_world": "Olá Mundo",
+		"empty":       "",
+		"texts": map[string]any{
+			"welcome":          "Bem-vindo ao meu jogo!",
+			"goodbye":          "Até logo!",
+			"key with=escapes": " valor com espaço\n",
+		},
+		"items": map[string]any{
+			"equipments": map[string]any{
+				"armor": map[string]any{
+					"singular": "{{.Name}} tem {{.Count}} Armadura.",
+					"plural":   "{{.Name}} tem {{.Count}} Armaduras.",
+					"none":     "{{.Name}} não tem Armadura.",
+				},
+			},
+		},
+	}
+
+	t.Run("utf-8", func(t *testing.T) {
+		file, err := os.Open("testdata/messages_pt_BR.properties")
+		require.NoError(t, err)
+		defer file.Close()
+
+		tree, err := DecodeProperties(file)
+		require.NoError(t, err)
+		require.Equal(t, expected, tree)
+	})
+
+	t.Run("iso-8859-1", func(t *testing.T) {
+		tree, err := DecodeProperties(strings.NewReader("texts.goodbye = At\xe9 logo!"))
+		require.NoError(t, err)
+		require.Equal(t, map[string]any{"texts": map[string]any{"goodbye": "Até logo!"}}, tree)
+	})
+
+	t.Run("surrogate pair", func(t *testing.T) {
+		tree, err := DecodeProperties(strings.NewReader(`emoji = \ud83d\ude00`))
+		require.NoError(t, err)
+		require.Equal(t, map[string]any{"emoji": "😀"}, tree)
+	})
+
+	t.Run("invalid unicode escape", func(t *testing.T) {
+		tree, err := DecodeProperties(strings.NewReader(`a = \uzzzz`))
+		require.ErrorIs(t, err, ErrInvalidProperties)
+		require.Nil(t, tree)
+	})
+
+	t.Run("keys extending other keys", func(t *testing.T) {
+		tree, err := DecodeProperties(strings.NewReader("error.notfound = Not found\nerror = Error\nerror.io.read = Read\nmenu.file = File\nmenu.file.open = Open"))
+		require.NoError(t, err)
+		require.Equal(t, map[string]any{
+			"error":          "Error",
+			"error.notfound": "Not found",
+			"error.io.read":  "Read",
+			"menu":           map[string]any{"file": "File", "file.open": "Open"},
+		}, tree)
+	})
+}
+
+func TestEncodeProperties(t *testing.T) {
+	messages := []Message{
+		{Key: "hello_world", Target: Forms{Singular: " Olá 😀 = Mundo\n"}},
+		{Key: "untranslated", Source: Forms{Singular: "Untranslated"}},
+		{Key: "items.armor", Plural: true, Target: Forms{Singular: "{{.Count}} Armadura", Plural: "{{.Count}} Armaduras", None: "Sem Armadura"}},
+		{Key: "error", Target: Forms{Singular: "Error"}},
+		{Key: `error\.notfound`, Target: Forms{Singular: "Not found"}},
+	}
+
+	var buf bytes.Buffer
+	err := EncodeProperties(&buf, messages)
+	require.NoError(t, err)
+	require.Equal(t, `hello_world = \ Ol\u00e1 \ud83d\ude00 = Mundo\n
+items.armor.singular = {{.Count}} Armadura
+items.armor.plural = {{.Count}} Armaduras
+items.armor.none = Sem Armadura
+error = Error
+error.notfound = Not found
+`, buf.String())
+
+	tree, err := DecodeProperties(&buf)
+	require.NoError(t, err)
+	require.Equal(t, map[string]any{
+		"hello_world": " Olá 😀 = Mundo\n",
+		"items": map[string]any{
+			"armor": map[string]any{"singular": "{{.Count}} Armadura", "plural": "{{.Count}} Armaduras", "none": "Sem Armadura"},
+		},
+		"error":          "Error",
+		"error.notfound": "Not found",
+	}, tree)
+}
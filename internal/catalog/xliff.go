@@ -0,0 +1,266 @@
+package catalog
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/leoviggiano/gotr/internal/scanner"
+)
+
+const (
+	XLIFF12 = "1.2"
+	XLIFF20 = "2.0"
+)
+
+var ErrInvalidXLIFF = errors.New("invalid xliff file")
+
+// Translation is the translated text of a key path, or of one of its plural forms.
+type Translation struct {
+	Key  string
+	Form string // Empty for messages without plural forms
+	Text string
+}
+
+// Path returns the key path the translation is stored at in a catalog with the plural blocks of forms.
+func (t Translation) Path(forms scanner.Forms) string {
+	if t.Form == "" {
+		return t.Key
+	}
+
+	return t.Key + "." + forms.Name(t.Form)
+}
+
+// xliffUnit is a translation unit; plural messages get one unit per form.
+type xliffUnit struct {
+	id     string
+	source string
+	target string
+	notes  []string
+}
+
+func xliffUnits(messages []Message) []xliffUnit {
+	var units []xliffUnit
+	for _, m := range messages {
+		notes := m.Metadata.Notes()
+		if !m.Plural {
+			units = append(units, xliffUnit{id: m.Key, source: m.Source.Singular, target: m.Target.Singular, notes: notes})
+			continue
+		}
+
+		forms := []struct {
+			name           string
+			source, target string
+		}{
+			{"singular", m.Source.Singular, m.Target.Singular},
+			{"plural", m.Source.Plural, m.Target.Plural},
+			{"none", m.Source.None, m.Target.None},
+		}
+
+		for _, form := range forms {
+			units = append(units, xliffUnit{
+				id:     fmt.Sprintf("%s[%s]", m.Key, form.name),
+				source: form.source,
+				target: form.target,
+				notes:  append(notes[:len(notes):len(notes)], fmt.Sprintf("Plural form %s of %s, used when the count is %s.", form.name, m.Key, formCount(form.name))),
+			})
+		}
+	}
+
+	return units
+}
+
+func formCount(form string) string {
+	switch form {
+	case "singular":
+		return "1"
+	case "none":
+		return "0"
+	default:
+		return "greater than 1"
+	}
+}
+
+type xliff12Document struct {
+	XMLName xml.Name `xml:"urn:oasis:names:tc:xliff:document:1.2 xliff"`
+	Version string   `xml:"version,attr"`
+	File    struct {
+		Original       string        `xml:"original,attr"`
+		SourceLanguage string        `xml:"source-language,attr"`
+		TargetLanguage string        `xml:"target-language,attr,omitempty"`
+		Datatype       string        `xml:"datatype,attr"`
+		Units          []xliff12Unit `xml:"body>trans-unit"`
+	} `xml:"file"`
+}
+
+type xliff12Unit struct {
+	ID     string   `xml:"id,attr"`
+	Source string   `xml:"source"`
+	Target *string  `xml:"target"`
+	Notes  []string `xml:"note"`
+}
+
+type xliff20Document struct {
+	XMLName xml.Name `xml:"urn:oasis:names:tc:xliff:document:2.0 xliff"`
+	Version string   `xml:"version,attr"`
+	SrcLang string   `xml:"srcLang,attr"`
+	TrgLang string   `xml:"trgLang,attr,omitempty"`
+	File    struct {
+		ID       string        `xml:"id,attr"`
+		Original string        `xml:"original,attr,omitempty"`
+		Units    []xliff20Unit `xml:"unit"`
+	} `xml:"file"`
+}
+
+type xliff20Unit struct {
+	ID      string   `xml:"id,attr"`
+	Notes   []string `xml:"notes>note,omitempty"`
+	Segment struct {
+		Source string  `xml:"source"`
+		Target *string `xml:"target"`
+	} `xml:"segment"`
+}
+
+// EncodeXLIFF writes messages as an XLIFF 1.2 or 2.0 document. Plural messages are split into one
+// unit per form, identified as "key[form]" and described by a note. The metadata of a message is
+// written as notes of its units.
+func EncodeXLIFF(w io.Writer, version, original, sourceLanguage, targetLanguage string, messages []Message) error {
+	units := xliffUnits(messages)
+
+	var doc any
+	switch version {
+	case XLIFF12:
+		d := xliff12Document{Version: XLIFF12}
+		d.File.Original = original
+		d.File.SourceLanguage = sourceLanguage
+		d.File.TargetLanguage = targetLanguage
+		d.File.Datatype = "plaintext"
+
+		for _, u := range units {
+			d.File.Units = append(d.File.Units, xliff12Unit{ID: u.id, Source: u.source, Target: optional(u.target), Notes: u.notes})
+		}
+
+		doc = d
+
+	case XLIFF20:
+		d := xliff20Document{Version: XLIFF20, SrcLang: sourceLanguage, TrgLang: targetLanguage}
+		d.File.ID = "f1"
+		d.File.Original = original
+
+		for _, u := range units {
+			unit := xliff20Unit{ID: u.id, Notes: u.notes}
+			unit.Segment.Source = u.source
+			unit.Segment.Target = optional(u.target)
+
+			d.File.Units = append(d.File.Units, unit)
+		}
+
+		doc = d
+
+	default:
+		return fmt.Errorf("%w: unsupported version %q", ErrInvalidXLIFF, version)
+	}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	if err := enc.Encode(doc); err != nil {
+		return err
+	}
+
+	_, err := io.WriteString(w, "\n")
+	return err
+}
+
+func optional(s string) *string {
+	if s == "" {
+		return nil
+	}
+
+	return &s
+}
+
+// DecodeXLIFF reads the translated units of an XLIFF 1.2 or 2.0 document. Units without a target
+// are skipped, and "key[form]" ids become plural form translations.
+func DecodeXLIFF(r io.Reader) ([]Translation, error) {
+	dec := xml.NewDecoder(r)
+	translations := []Translation{}
+	root := false
+
+	for {
+		token, err := dec.Token()
+		if err == io.EOF {
+			break
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidXLIFF, err)
+		}
+
+		start, ok := token.(xml.StartElement)
+		if !ok {
+			continue
+		}
+
+		switch start.Name.Local {
+		case "xliff":
+			root = true
+
+		case "trans-unit", "unit":
+			var unit struct {
+				ID       string  `xml:"id,attr"`
+				Target   *string `xml:"target"`
+				Segments []struct {
+					Target *string `xml:"target"`
+				} `xml:"segment"`
+			}
+
+			if err := dec.DecodeElement(&unit, &start); err != nil {
+				return nil, fmt.Errorf("%w: %v", ErrInvalidXLIFF, err)
+			}
+
+			target := ""
+			if unit.Target != nil {
+				target = *unit.Target
+			}
+
+			for _, segment := range unit.Segments {
+				if segment.Target != nil {
+					target += *segment.Target
+				}
+			}
+
+			if target == "" || unit.ID == "" {
+				continue
+			}
+
+			translations = append(translations, unitTranslation(unit.ID, target))
+		}
+	}
+
+	if !root {
+		return nil, fmt.Errorf("%w: missing xliff element", ErrInvalidXLIFF)
+	}
+
+	return translations, nil
+}
+
+func unitTranslation(id, text string) Translation {
+	if strings.HasSuffix(id, "]") {
+		if idx := strings.LastIndex(id, "["); idx > 0 {
+			form := id[idx+1 : len(id)-1]
+			for _, f := range PluralForms {
+				if form == f {
+					return Translation{Key: id[:idx], Form: form, Text: text}
+				}
+			}
+		}
+	}
+
+	return Translation{Key: id, Text: text}
+}
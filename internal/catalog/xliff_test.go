@@ -0,0 +1,131 @@
+package catalog
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/leoviggiano/gotr/internal/metadata"
+	"github.com/leoviggiano/gotr/internal/scanner"
+	"github.com/stretchr/testify/require"
+)
+
+func TestXLIFF(t *testing.T) {
+	messages := []Message{
+		{
+			Key:    "hello_world",
+			Source: Forms{Singular: "Hello World", Plural: "Hello World", None: "Hello World"},
+			Target: Forms{Singular: "Olá Mundo", Plural: "Olá Mundo", None: "Olá Mundo"},
+		},
+		{
+			Key:    "hello_world2",
+			Source: Forms{Singular: "Hello World 2", Plural: "Hello World 2", None: "Hello World 2"},
+		},
+		{
+			Key:      "armor",
+			Plural:   true,
+			Source:   Forms{Singular: "{{.Count}} Armor", Plural: "{{.Count}} Armors", None: "No Armor & Shield"},
+			Target:   Forms{Singular: "{{.Count}} Armadura", Plural: "{{.Count}} Armaduras", None: "Sem Armadura & Escudo"},
+			Metadata: metadata.Metadata{Context: "inventory"},
+		},
+	}
+
+	expected := []Translation{
+		{Key: "hello_world", Text: "Olá Mundo"},
+		{Key: "armor", Form: "singular", Text: "{{.Count}} Armadura"},
+		{Key: "armor", Form: "plural", Text: "{{.Count}} Armaduras"},
+		{Key: "armor", Form: "none", Text: "Sem Armadura & Escudo"},
+	}
+
+	t.Run("1.2", func(t *testing.T) {
+		var buf bytes.Buffer
+		err := EncodeXLIFF(&buf, XLIFF12, "pt_BR.json", "en", "pt", messages)
+		require.NoError(t, err)
+
+		xliff := buf.String()
+		require.Contains(t, xliff, `<xliff xmlns="urn:oasis:names:tc:xliff:document:1.2" version="1.2">`)
+		require.Contains(t, xliff, `<file original="pt_BR.json" source-language="en" target-language="pt" datatype="plaintext">`)
+		require.Contains(t, xliff, `<trans-unit id="hello_world2">
+        <source>Hello World 2</source>
+      </trans-unit>`)
+		require.Contains(t, xliff, `<trans-unit id="armor[none]">
+        <source>No Armor &amp; Shield</source>
+        <target>Sem Armadura &amp; Escudo</target>
+        <note>Context: inventory</note>
+        <note>Plural form none of armor, used when the count is 0.</note>
+      </trans-unit>`)
+
+		translations, err := DecodeXLIFF(&buf)
+		require.NoError(t, err)
+		require.Equal(t, expected, translations)
+	})
+
+	t.Run("2.0", func(t *testing.T) {
+		var buf bytes.Buffer
+		err := EncodeXLIFF(&buf, XLIFF20, "pt_BR.json", "en", "pt", messages)
+		require.NoError(t, err)
+
+		xliff := buf.String()
+		require.Contains(t, xliff, `<xliff xmlns="urn:oasis:names:tc:xliff:document:2.0" version="2.0" srcLang="en" trgLang="pt">`)
+		require.Contains(t, xliff, `<unit id="armor[singular]">
+      <notes>
+        <note>Context: inventory</note>
+        <note>Plural form singular of armor, used when the count is 1.</note>
+      </notes>
+      <segment>
+        <source>{{.Count}} Armor</source>
+        <target>{{.Count}} Armadura</target>
+      </segment>
+    </unit>`)
+
+		translations, err := DecodeXLIFF(&buf)
+		require.NoError(t, err)
+		require.Equal(t, expected, translations)
+		require.Equal(t, "armor.singular", translations[1].Path(scanner.DefaultForms))
+		require.Equal(t, "armor.one", translations[1].Path(scanner.Forms{Singular: "one", Plural: "other", None: "zero"}))
+	})
+
+	t.Run("groups", func(t *testing.T) {
+		xliff := `<xliff version="1.2"><file><body><group id="g">
+			<trans-unit id="a"><source>A</source><target>Á</target></trans-unit>
+			<trans-unit id="b[other]"><source>B</source><target>Bê</target></trans-unit>
+		</group></body></file></xliff>`
+
+		translations, err := DecodeXLIFF(strings.NewReader(xliff))
+		require.NoError(t, err)
+		require.Equal(t, []Translation{{Key: "a", Text: "Á"}, {Key: "b[other]", Text: "Bê"}}, translations)
+		require.Equal(t, "a", translations[0].Path(scanner.DefaultForms))
+	})
+
+	t.Run("error - unsupported version", func(t *testing.T) {
+		err := EncodeXLIFF(&bytes.Buffer{}, "3.0", "", "en", "pt", messages)
+		require.ErrorIs(t, err, ErrInvalidXLIFF)
+	})
+
+	t.Run("error - not xliff", func(t *testing.T) {
+		_, err := DecodeXLIFF(strings.NewReader(`<resources></resources>`))
+		require.ErrorIs(t, err, ErrInvalidXLIFF)
+	})
+
+	t.Run("error - invalid xml", func(t *testing.T) {
+		_, err := DecodeXLIFF(strings.NewReader(`<xliff><file>`))
+		require.ErrorIs(t, err, ErrInvalidXLIFF)
+	})
+}
+
+func TestMessages(t *testing.T) {
+	source := map[string]Entry{
+		"a": {Forms: map[string]string{"a": "A"}},
+		"b": {Plural: true, Forms: map[string]string{"singular": "B", "plural": "Bs", "none": "No B"}, Metadata: metadata.Metadata{Description: "Bees"}},
+	}
+	target := map[string]Entry{
+		"a": {Forms: map[string]string{"a": "Á"}},
+		"c": {Forms: map[string]string{"c": "Cê"}},
+	}
+
+	require.Equal(t, []Message{
+		{Key: "a", Source: Forms{"A", "A", "A"}, Target: Forms{"Á", "Á", "Á"}},
+		{Key: "b", Plural: true, Source: Forms{"B", "Bs", "No B"}, Metadata: metadata.Metadata{Description: "Bees"}},
+		{Key: "c", Source: Forms{"Cê", "Cê", "Cê"}, Target: Forms{"Cê", "Cê", "Cê"}},
+	}, Messages(source, target))
+}
@@ -0,0 +1,56 @@
+package catalog
+
+import (
+	"fmt"
+	"io"
+
+	"gopkg.in/yaml.v3"
+)
+
+// DecodeYAML decodes a YAML catalog, turning every mapping into the same key tree a JSON catalog has.
+func DecodeYAML(r io.Reader) (map[string]any, error) {
+	var v map[string]any
+	err := yaml.NewDecoder(r).Decode(&v)
+	if err != nil && err != io.EOF {
+		return nil, err
+	}
+
+	tree, _ := normalizeYAML(v).(map[string]any)
+	if tree == nil {
+		tree = map[string]any{}
+	}
+
+	return tree, nil
+}
+
+// normalizeYAML converts mappings with non-string keys, which JSON can't represent, into string keyed maps.
+func normalizeYAML(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		result := make(map[string]any, len(v))
+		for k, value := range v {
+			result[k] = normalizeYAML(value)
+		}
+
+		return result
+
+	case map[any]any:
+		result := make(map[string]any, len(v))
+		for k, value := range v {
+			result[fmt.Sprintf("%v", k)] = normalizeYAML(value)
+		}
+
+		return result
+
+	case []any:
+		result := make([]any, len(v))
+		for i, value := range v {
+			result[i] = normalizeYAML(value)
+		}
+
+		return result
+
+	default:
+		return v
+	}
+}
@@ -0,0 +1,52 @@
+package catalog
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/leoviggiano/gotr/internal/metadata"
+	"github.com/leoviggiano/gotr/internal/scanner"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecodeYAML(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tree, err := Load("./testdata/en_US.yaml")
+		require.NoError(t, err)
+
+		entries, err := Entries(tree, scanner.DefaultForms)
+		require.NoError(t, err)
+
+		require.Equal(t, "Hello World", entries["hello_world"].Text())
+		require.Equal(t, "Goodbye!", entries["texts.goodbye"].Text())
+		require.Equal(t, "First level", entries["levels.1"].Text())
+		require.Equal(t, Entry{
+			Plural: true,
+			Forms: map[string]string{
+				"singular": "{{.Name}} has {{.Count}} Armor.",
+				"plural":   "{{.Name}} has {{.Count}} Armors.",
+				"none":     "{{.Name}} has no Armor.",
+			},
+			Metadata: metadata.Metadata{Description: "Armor text"},
+		}, entries["items.equipments.armor"])
+		require.NotContains(t, entries, "items.equipments.armor.description")
+	})
+
+	t.Run("non string keys", func(t *testing.T) {
+		tree, err := DecodeYAML(strings.NewReader("1:\n  2: two\n"))
+		require.NoError(t, err)
+		require.Equal(t, map[string]any{"1": map[string]any{"2": "two"}}, tree)
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		tree, err := DecodeYAML(strings.NewReader(""))
+		require.NoError(t, err)
+		require.Empty(t, tree)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tree, err := DecodeYAML(strings.NewReader("- invalid"))
+		require.Error(t, err)
+		require.Nil(t, tree)
+	})
+}
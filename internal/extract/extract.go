@@ -0,0 +1,361 @@
+package extract
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/leoviggiano/gotr/internal/catalog"
+)
+
+const gotrPath = "github.com/leoviggiano/gotr"
+
+// Usage is a constant translation key referenced from Go source code.
+type Usage struct {
+	Key string         `json:"key"`
+	Pos token.Position `json:"pos"`
+}
+
+// Dirs expands the given patterns into package directories. A pattern ending in "/..." matches the
+// directory and all its subdirectories, skipping vendor, testdata and hidden directories.
+func Dirs(patterns []string) ([]string, error) {
+	seen := make(map[string]struct{})
+	dirs := []string{}
+
+	add := func(dir string) {
+		if _, ok := seen[dir]; !ok {
+			seen[dir] = struct{}{}
+			dirs = append(dirs, dir)
+		}
+	}
+
+	for _, pattern := range patterns {
+		root, recursive := strings.CutSuffix(pattern, "...")
+		if !recursive {
+			add(filepath.Clean(pattern))
+			continue
+		}
+
+		root = filepath.Clean(strings.TrimSuffix(root, "/"))
+		if root == "" {
+			root = "."
+		}
+
+		err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+
+			if !d.IsDir() {
+				return nil
+			}
+
+			name := d.Name()
+			if path != root && (name == "vendor" || name == "testdata" || strings.HasPrefix(name, ".") || strings.HasPrefix(name, "_")) {
+				return filepath.SkipDir
+			}
+
+			add(path)
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return dirs, nil
+}
+
+// Extract parses the Go files of every directory and returns the constant keys passed as Localizer,
+// either in gotr.Args literals, in assignments to a Localizer field or as the first argument of a
+// Localizer method call.
+func Extract(dirs []string) ([]Usage, error) {
+	usages := []Usage{}
+	fset := token.NewFileSet()
+
+	for _, dir := range dirs {
+		files, err := parseDir(fset, dir)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, pkgFiles := range files {
+			usages = append(usages, extractPackage(fset, pkgFiles)...)
+		}
+	}
+
+	sort.SliceStable(usages, func(i, j int) bool {
+		a, b := usages[i].Pos, usages[j].Pos
+		if a.Filename != b.Filename {
+			return a.Filename < b.Filename
+		}
+
+		if a.Line != b.Line {
+			return a.Line < b.Line
+		}
+
+		return a.Column < b.Column
+	})
+
+	return usages, nil
+}
+
+// parseDir parses the Go files of dir grouped by package name.
+func parseDir(fset *token.FileSet, dir string) (map[string][]*ast.File, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	files := make(map[string][]*ast.File)
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), ".go") {
+			continue
+		}
+
+		file, err := parser.ParseFile(fset, filepath.Join(dir, entry.Name()), nil, parser.SkipObjectResolution)
+		if err != nil {
+			return nil, err
+		}
+
+		files[file.Name.Name] = append(files[file.Name.Name], file)
+	}
+
+	return files, nil
+}
+
+func extractPackage(fset *token.FileSet, files []*ast.File) []Usage {
+	consts := constants(files)
+	usages := []Usage{}
+
+	add := func(expr ast.Expr) {
+		key, ok := evaluate(expr, consts)
+		if ok {
+			usages = append(usages, Usage{Key: key, Pos: fset.Position(expr.Pos())})
+		}
+	}
+
+	for _, file := range files {
+		gotrName := importName(file)
+
+		ast.Inspect(file, func(n ast.Node) bool {
+			switch n := n.(type) {
+			case *ast.CompositeLit:
+				if !isArgs(n.Type, gotrName, file.Name.Name) {
+					return true
+				}
+
+				for _, elt := range n.Elts {
+					kv, ok := elt.(*ast.KeyValueExpr)
+					if ok && isIdent(kv.Key, "Localizer") {
+						add(kv.Value)
+					}
+				}
+
+			case *ast.AssignStmt:
+				if len(n.Lhs) != len(n.Rhs) {
+					return true
+				}
+
+				for i, lhs := range n.Lhs {
+					sel, ok := lhs.(*ast.SelectorExpr)
+					if ok && sel.Sel.Name == "Localizer" {
+						add(n.Rhs[i])
+					}
+				}
+
+			case *ast.CallExpr:
+				sel, ok := n.Fun.(*ast.SelectorExpr)
+				if ok && sel.Sel.Name == "Localizer" && len(n.Args) > 0 {
+					add(n.Args[0])
+				}
+			}
+
+			return true
+		})
+	}
+
+	return usages
+}
+
+// importName returns the name gotr is imported with in file, or an empty string.
+func importName(file *ast.File) string {
+	for _, imp := range file.Imports {
+		path, err := strconv.Unquote(imp.Path.Value)
+		if err != nil || path != gotrPath {
+			continue
+		}
+
+		if imp.Name != nil {
+			return imp.Name.Name
+		}
+
+		return "gotr"
+	}
+
+	return ""
+}
+
+func isArgs(expr ast.Expr, gotrName, pkgName string) bool {
+	switch expr := expr.(type) {
+	case *ast.SelectorExpr:
+		return gotrName != "" && isIdent(expr.X, gotrName) && expr.Sel.Name == "Args"
+	case *ast.Ident:
+		return pkgName == "gotr" && expr.Name == "Args"
+	default:
+		return false
+	}
+}
+
+func isIdent(expr ast.Expr, name string) bool {
+	ident, ok := expr.(*ast.Ident)
+	return ok && ident.Name == name
+}
+
+// constants collects the package level and local string constants declared in files.
+func constants(files []*ast.File) map[string]ast.Expr {
+	consts := make(map[string]ast.Expr)
+
+	for _, file := range files {
+		ast.Inspect(file, func(n ast.Node) bool {
+			decl, ok := n.(*ast.GenDecl)
+			if !ok || decl.Tok != token.CONST {
+				return true
+			}
+
+			for _, spec := range decl.Specs {
+				value, ok := spec.(*ast.ValueSpec)
+				if !ok || len(value.Names) != len(value.Values) {
+					continue
+				}
+
+				for i, name := range value.Names {
+					consts[name.Name] = value.Values[i]
+				}
+			}
+
+			return true
+		})
+	}
+
+	return consts
+}
+
+// evaluate resolves expr to a constant string.
+func evaluate(expr ast.Expr, consts map[string]ast.Expr) (string, bool) {
+	return evaluateDepth(expr, consts, 0)
+}
+
+func evaluateDepth(expr ast.Expr, consts map[string]ast.Expr, depth int) (string, bool) {
+	if depth > len(consts) {
+		return "", false
+	}
+
+	switch expr := expr.(type) {
+	case *ast.BasicLit:
+		if expr.Kind != token.STRING {
+			return "", false
+		}
+
+		value, err := strconv.Unquote(expr.Value)
+		return value, err == nil
+
+	case *ast.ParenExpr:
+		return evaluateDepth(expr.X, consts, depth)
+
+	case *ast.Ident:
+		value, ok := consts[expr.Name]
+		if !ok {
+			return "", false
+		}
+
+		return evaluateDepth(value, consts, depth+1)
+
+	case *ast.BinaryExpr:
+		if expr.Op != token.ADD {
+			return "", false
+		}
+
+		x, ok := evaluateDepth(expr.X, consts, depth)
+		if !ok {
+			return "", false
+		}
+
+		y, ok := evaluateDepth(expr.Y, consts, depth)
+		if !ok {
+			return "", false
+		}
+
+		return x + y, true
+
+	default:
+		return "", false
+	}
+}
+
+// Report compares the keys used in code with the keys of the default catalog.
+type Report struct {
+	Missing []Usage  `json:"missing"` // Keys used in code that are neither a key path nor a text of the catalog
+	Unused  []string `json:"unused"`  // Key paths of the catalog never referenced by path or text
+}
+
+// Compare matches every usage against the key paths and the texts of every form of entries, whatever
+// their whitespace, and against the lists whose elements are indexed under the key.
+func Compare(usages []Usage, entries map[string]catalog.Entry) Report {
+	report := Report{Missing: []Usage{}, Unused: []string{}}
+
+	texts := make(map[string]struct{}, len(entries))
+	for _, e := range entries {
+		for _, text := range e.Texts() {
+			texts[catalog.NormalizeWhitespace(text)] = struct{}{}
+		}
+	}
+
+	used := make(map[string]struct{}, len(usages))
+	for _, usage := range usages {
+		used[usage.Key] = struct{}{}
+		used[catalog.NormalizeWhitespace(usage.Key)] = struct{}{}
+
+		// A list is used through its key, and its elements with it.
+		elements := catalog.ListPaths(entries, usage.Key)
+		for _, path := range elements {
+			used[path] = struct{}{}
+		}
+
+		_, isPath := entries[usage.Key]
+		_, isText := texts[catalog.NormalizeWhitespace(usage.Key)]
+		if !isPath && !isText && len(elements) == 0 {
+			report.Missing = append(report.Missing, usage)
+		}
+	}
+
+	for path, e := range entries {
+		if !isUsed(path, e, used) {
+			report.Unused = append(report.Unused, path)
+		}
+	}
+
+	sort.Strings(report.Unused)
+	return report
+}
+
+// isUsed reports whether the entry at path is used by its path or by any of its texts.
+func isUsed(path string, e catalog.Entry, used map[string]struct{}) bool {
+	if _, ok := used[path]; ok {
+		return true
+	}
+
+	for _, text := range e.Texts() {
+		if _, ok := used[catalog.NormalizeWhitespace(text)]; ok {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,103 @@
+package extract
+
+import (
+	"encoding/json"
+	"path/filepath"
+	"testing"
+
+	"github.com/leoviggiano/gotr/internal/catalog"
+	"github.com/leoviggiano/gotr/internal/scanner"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDirs(t *testing.T) {
+	t.Run("single directory", func(t *testing.T) {
+		dirs, err := Dirs([]string{"./testdata/app"})
+		require.NoError(t, err)
+		require.Equal(t, []string{filepath.Join("testdata", "app")}, dirs)
+	})
+
+	t.Run("recursive", func(t *testing.T) {
+		dirs, err := Dirs([]string{"../catalog/..."})
+		require.NoError(t, err)
+		require.Equal(t, []string{filepath.Join("..", "catalog")}, dirs)
+	})
+
+	t.Run("skips testdata", func(t *testing.T) {
+		dirs, err := Dirs([]string{"./..."})
+		require.NoError(t, err)
+		require.Equal(t, []string{"."}, dirs)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		_, err := Dirs([]string{"./invalid/..."})
+		require.Error(t, err)
+	})
+}
+
+func TestExtract(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		usages, err := Extract([]string{"./testdata/app"})
+		require.NoError(t, err)
+
+		keys := make([]string, 0, len(usages))
+		for _, usage := range usages {
+			keys = append(keys, usage.Key)
+			require.Equal(t, filepath.Join("testdata", "app", "app.go"), usage.Pos.Filename)
+		}
+
+		require.Equal(t, []string{
+			"hello_world",
+			"{{.Name}} has {{.Count}} Armor.",
+			"texts.missing",
+			"texts.welcome",
+			"items.equipments.armor",
+		}, keys)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		_, err := Extract([]string{"./invalid"})
+		require.Error(t, err)
+	})
+}
+
+func TestCompare(t *testing.T) {
+	var tree map[string]any
+	err := json.Unmarshal([]byte(`{
+		"hello_world": "Hello World",
+		"texts": {"welcome": "Welcome", "goodbye": "Goodbye"},
+		"armor": {"singular": "{{.Name}} has {{.Count}} Armor.", "plural": "{{.Name}} has {{.Count}} Armors.", "none": "No Armor"},
+		"tips": ["Rest", "Eat"]
+	}`), &tree)
+	require.NoError(t, err)
+
+	entries, err := catalog.Entries(tree, scanner.DefaultForms)
+	require.NoError(t, err)
+
+	usages := []Usage{
+		{Key: "hello_world"},
+		{Key: "Welcome"},
+		{Key: "{{.Name}} has {{.Count}} Armor."},
+		{Key: "texts.missing"},
+		{Key: "tips"},
+	}
+
+	report := Compare(usages, entries)
+	require.Equal(t, []Usage{{Key: "texts.missing"}}, report.Missing)
+	require.Equal(t, []string{"texts.goodbye"}, report.Unused)
+
+	t.Run("other forms and whitespace", func(t *testing.T) {
+		usages := []Usage{{Key: "No Armor"}, {Key: "hello_world"}, {Key: "  Goodbye\n"}, {Key: "Welcome"}, {Key: "tips"}}
+
+		report := Compare(usages, entries)
+		require.Empty(t, report.Missing)
+		require.Empty(t, report.Unused)
+	})
+
+	t.Run("list elements", func(t *testing.T) {
+		report := Compare([]Usage{{Key: "tips.1"}, {Key: "tips.0.text"}}, entries)
+		require.Equal(t, []Usage{{Key: "tips.0.text"}}, report.Missing)
+		require.Contains(t, report.Unused, "tips.0")
+		require.NotContains(t, report.Unused, "tips.1")
+	})
+}
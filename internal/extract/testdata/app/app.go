@@ -0,0 +1,33 @@
+package app
+
+import (
+	tr "github.com/leoviggiano/gotr"
+)
+
+const (
+	prefix  = "texts."
+	welcome = prefix + "welcome"
+)
+
+type message struct{}
+
+func (message) Localizer(key string) string { return key }
+
+func Messages(translator tr.Translator, dynamic string) []string {
+	args := tr.Args{Identifier: "pt", Localizer: "hello_world"}
+	text := tr.Args{Localizer: "{{.Name}} has {{.Count}} Armor."}
+	missing := tr.Args{Localizer: "texts.missing"}
+	skipped := tr.Args{Localizer: dynamic}
+
+	var welcomeArgs tr.Args
+	welcomeArgs.Localizer = welcome
+
+	return []string{
+		translator.Get(args),
+		translator.Get(text),
+		translator.Get(missing),
+		translator.Get(skipped),
+		translator.Get(welcomeArgs),
+		message{}.Localizer("items.equipments.armor"),
+	}
+}
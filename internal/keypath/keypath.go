@@ -0,0 +1,73 @@
+// Package keypath splits and joins the dot separated key paths of translation catalogs. A dot or a
+// backslash that is part of a key is escaped with a backslash, so "v1\.2" is the single key "v1.2".
+package keypath
+
+import (
+	"strconv"
+	"strings"
+)
+
+const separator = '.'
+
+// Escape escapes the dots and backslashes of a key so it stays a single segment of a path.
+func Escape(key string) string {
+	if !strings.ContainsAny(key, `.\`) {
+		return key
+	}
+
+	var b strings.Builder
+	for i := 0; i < len(key); i++ {
+		if key[i] == separator || key[i] == '\\' {
+			b.WriteByte('\\')
+		}
+
+		b.WriteByte(key[i])
+	}
+
+	return b.String()
+}
+
+// Join escapes every segment and joins them into a path.
+func Join(segments ...string) string {
+	escaped := make([]string, len(segments))
+	for i, segment := range segments {
+		escaped[i] = Escape(segment)
+	}
+
+	return strings.Join(escaped, string(separator))
+}
+
+// Split splits path on its unescaped dots and unescapes the segments.
+func Split(path string) []string {
+	if !strings.Contains(path, `\`) {
+		return strings.Split(path, string(separator))
+	}
+
+	var segments []string
+	var b strings.Builder
+	for i := 0; i < len(path); i++ {
+		switch {
+		case path[i] == '\\' && i+1 < len(path):
+			i++
+			b.WriteByte(path[i])
+		case path[i] == separator:
+			segments = append(segments, b.String())
+			b.Reset()
+		default:
+			b.WriteByte(path[i])
+		}
+	}
+
+	return append(segments, b.String())
+}
+
+// Index returns the list index segment names in a list of length elements. Indexes are written in
+// decimal without sign or leading zeros.
+func Index(segment string, length int) (int, bool) {
+	idx, err := strconv.Atoi(segment)
+	if err != nil || idx < 0 || idx >= length || strconv.Itoa(idx) != segment {
+		return 0, false
+	}
+
+	return idx, true
+}
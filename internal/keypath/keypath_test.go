@@ -0,0 +1,60 @@
+package keypath
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestKeypath(t *testing.T) {
+	tt := []struct {
+		name     string
+		path     string
+		segments []string
+	}{
+		{name: "single key", path: "hello_world", segments: []string{"hello_world"}},
+		{name: "nested keys", path: "items.equipments.armor", segments: []string{"items", "equipments", "armor"}},
+		{name: "escaped dot", path: `versions.v1\.2`, segments: []string{"versions", "v1.2"}},
+		{name: "domain", path: `links.example\.com.title`, segments: []string{"links", "example.com", "title"}},
+		{name: "escaped backslash", path: `paths.C:\\`, segments: []string{"paths", `C:\`}},
+		{name: "empty", path: "", segments: []string{""}},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.segments, Split(tc.path))
+			require.Equal(t, tc.path, Join(tc.segments...))
+		})
+	}
+
+	t.Run("trailing backslash", func(t *testing.T) {
+		require.Equal(t, []string{"a", `b\`}, Split(`a.b\`))
+	})
+
+	t.Run("escape", func(t *testing.T) {
+		require.Equal(t, "hello", Escape("hello"))
+		require.Equal(t, `Hello\. World\\`, Escape(`Hello. World\`))
+	})
+
+	t.Run("index", func(t *testing.T) {
+		tt := []struct {
+			segment string
+			idx     int
+			ok      bool
+		}{
+			{segment: "0", idx: 0, ok: true},
+			{segment: "2", idx: 2, ok: true},
+			{segment: "3"},
+			{segment: "-1"},
+			{segment: "01"},
+			{segment: "+1"},
+			{segment: "tips"},
+		}
+
+		for _, tc := range tt {
+			idx, ok := Index(tc.segment, 3)
+			require.Equal(t, tc.ok, ok, tc.segment)
+			require.Equal(t, tc.idx, idx, tc.segment)
+		}
+	})
+}
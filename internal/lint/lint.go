@@ -0,0 +1,175 @@
+package lint
+
+import (
+	"sort"
+
+	"github.com/leoviggiano/gotr/internal/catalog"
+	"github.com/leoviggiano/gotr/internal/scanner"
+)
+
+type Kind string
+
+const (
+	MissingKey        Kind = "missing_key"
+	ExtraKey          Kind = "extra_key"
+	EmptyValue        Kind = "empty_value"
+	MissingPluralForm Kind = "missing_plural_form"
+)
+
+// Issue is a single problem found in a catalog.
+type Issue struct {
+	File string `json:"file"`
+	Key  string `json:"key"`
+	Kind Kind   `json:"kind"`
+	Form string `json:"form,omitempty"`
+}
+
+// Catalog is a translation file already decoded into its key tree.
+type Catalog struct {
+	File   string
+	Locale string // Identifier of the locale of the file, whose target files are compared together
+	Tree   map[string]any
+}
+
+// Lint checks every catalog of the default locale and every target catalog for empty values and
+// missing plural forms, those of the default locale for target keys, and reports the keys each target
+// locale lacks or adds compared to the default locale.
+// Target catalogs sharing a Locale are one locale split in several files: a key is missing when none
+// of them has it, and is reported against the first of them. A catalog without a Locale stands alone.
+// Plural blocks hold the forms named by forms, and issues name the forms the same way.
+func Lint(defaults []Catalog, targets []Catalog, forms scanner.Forms) ([]Issue, error) {
+	var issues []Issue
+	base := make(map[string]catalog.Entry)
+
+	for _, c := range defaults {
+		entries, err := catalog.Entries(c.Tree, forms)
+		if err != nil {
+			return nil, err
+		}
+
+		for path, e := range entries {
+			base[path] = e
+		}
+
+		issues = append(issues, checkEntries(c.File, entries, nil, forms)...)
+	}
+
+	for _, locale := range groupLocales(targets) {
+		keys := make(map[string]struct{})
+		for _, c := range locale {
+			entries, err := catalog.Entries(c.Tree, forms)
+			if err != nil {
+				return nil, err
+			}
+
+			issues = append(issues, checkEntries(c.File, entries, base, forms)...)
+
+			for path := range entries {
+				keys[path] = struct{}{}
+				if _, ok := base[path]; !ok {
+					issues = append(issues, Issue{File: c.File, Key: path, Kind: ExtraKey})
+				}
+			}
+		}
+
+		for path := range base {
+			if _, ok := keys[path]; !ok {
+				issues = append(issues, Issue{File: locale[0].File, Key: path, Kind: MissingKey})
+			}
+		}
+	}
+
+	sort.SliceStable(issues, func(i, j int) bool {
+		if issues[i].File != issues[j].File {
+			return issues[i].File < issues[j].File
+		}
+
+		if issues[i].Key != issues[j].Key {
+			return issues[i].Key < issues[j].Key
+		}
+
+		if issues[i].Kind != issues[j].Kind {
+			return issues[i].Kind < issues[j].Kind
+		}
+
+		return issues[i].Form < issues[j].Form
+	})
+
+	return issues, nil
+}
+
+// groupLocales groups catalogs by their Locale, in the order the locales first appear.
+func groupLocales(catalogs []Catalog) [][]Catalog {
+	var locales [][]Catalog
+	index := make(map[string]int)
+
+	for _, c := range catalogs {
+		if c.Locale == "" {
+			locales = append(locales, []Catalog{c})
+			continue
+		}
+
+		i, ok := index[c.Locale]
+		if !ok {
+			i = len(locales)
+			index[c.Locale] = i
+			locales = append(locales, nil)
+		}
+
+		locales[i] = append(locales[i], c)
+	}
+
+	return locales
+}
+
+// checkEntries reports the empty values of entries and the plural forms they lack, compared to the
+// entries of base, the default locale, for the keys plural there.
+func checkEntries(file string, entries, base map[string]catalog.Entry, forms scanner.Forms) []Issue {
+	var issues []Issue
+
+	for path, e := range entries {
+		expected := expectedForms(e, base[path])
+		if len(expected) == 0 {
+			for _, v := range e.Forms {
+				if v == "" {
+					issues = append(issues, Issue{File: file, Key: path, Kind: EmptyValue})
+				}
+			}
+
+			continue
+		}
+
+		for _, form := range expected {
+			v, ok := e.Forms[form]
+			switch {
+			case !ok:
+				issues = append(issues, Issue{File: file, Key: path, Kind: MissingPluralForm, Form: forms.Name(form)})
+			case v == "":
+				issues = append(issues, Issue{File: file, Key: path, Kind: EmptyValue, Form: forms.Name(form)})
+			}
+		}
+	}
+
+	return issues
+}
+
+// expectedForms returns the plural forms e must hold: the forms of source when source is plural, all
+// of them when only e is, and none otherwise.
+func expectedForms(e, source catalog.Entry) []string {
+	if !source.Plural {
+		if e.Plural {
+			return catalog.PluralForms
+		}
+
+		return nil
+	}
+
+	var expected []string
+	for _, form := range catalog.PluralForms {
+		if _, ok := source.Forms[form]; ok {
+			expected = append(expected, form)
+		}
+	}
+
+	return expected
+}
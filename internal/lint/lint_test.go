@@ -0,0 +1,120 @@
+package lint
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/leoviggiano/gotr/internal/scanner"
+	"github.com/stretchr/testify/require"
+)
+
+func tree(t *testing.T, data string) map[string]any {
+	t.Helper()
+
+	var v map[string]any
+	require.NoError(t, json.Unmarshal([]byte(data), &v))
+	return v
+}
+
+func TestLint(t *testing.T) {
+	defaults := []Catalog{
+		{File: "en.json", Tree: tree(t, `{
+			"hello": "Hello",
+			"texts": {"goodbye": "Goodbye"},
+			"armor": {"singular": "1 Armor", "plural": "{{.Count}} Armors", "none": "No Armor"}
+		}`)},
+	}
+
+	t.Run("success", func(t *testing.T) {
+		targets := []Catalog{
+			{File: "pt.json", Tree: tree(t, `{
+				"hello": "Olá",
+				"texts": {"goodbye": "Tchau"},
+				"armor": {"singular": "1 Armadura", "plural": "{{.Count}} Armaduras", "none": "Sem Armadura"}
+			}`)},
+		}
+
+		issues, err := Lint(defaults, targets, scanner.DefaultForms)
+		require.NoError(t, err)
+		require.Empty(t, issues)
+	})
+
+	t.Run("issues", func(t *testing.T) {
+		targets := []Catalog{
+			{File: "pt.json", Tree: tree(t, `{
+				"hello": "",
+				"extra": "Extra",
+				"armor": {"singular": "1 Armadura", "plural": ""}
+			}`)},
+		}
+
+		issues, err := Lint(defaults, targets, scanner.DefaultForms)
+		require.NoError(t, err)
+		require.Equal(t, []Issue{
+			{File: "pt.json", Key: "armor", Kind: EmptyValue, Form: "plural"},
+			{File: "pt.json", Key: "armor", Kind: MissingPluralForm, Form: "none"},
+			{File: "pt.json", Key: "extra", Kind: ExtraKey},
+			{File: "pt.json", Key: "hello", Kind: EmptyValue},
+			{File: "pt.json", Key: "texts.goodbye", Kind: MissingKey},
+		}, issues)
+	})
+
+	t.Run("split locales", func(t *testing.T) {
+		targets := []Catalog{
+			{File: "pt.json", Locale: "pt", Tree: tree(t, `{"hello": "Olá"}`)},
+			{File: "es.json", Locale: "es", Tree: tree(t, `{"hello": "Hola", "texts": {"goodbye": "Adiós"}}`)},
+			{File: "pt_items.json", Locale: "pt", Tree: tree(t, `{
+				"armor": {"singular": "1 Armadura", "plural": "{{.Count}} Armaduras", "none": "Sem Armadura"},
+				"extra": "Extra"
+			}`)},
+		}
+
+		issues, err := Lint(defaults, targets, scanner.DefaultForms)
+		require.NoError(t, err)
+		require.Equal(t, []Issue{
+			{File: "es.json", Key: "armor", Kind: MissingKey},
+			{File: "pt.json", Key: "texts.goodbye", Kind: MissingKey},
+			{File: "pt_items.json", Key: "extra", Kind: ExtraKey},
+		}, issues)
+	})
+
+	t.Run("plural forms of the default locale", func(t *testing.T) {
+		defaults := []Catalog{{File: "en.json", Tree: tree(t, `{
+			"armor": {"singular": "1 Armor", "plural": "{{.Count}} Armors", "none": "No Armor"},
+			"shield": {"singular": "1 Shield", "plural": "{{.Count}} Shields"},
+			"sword": "Sword"
+		}`)}}
+		targets := []Catalog{{File: "pt.json", Tree: tree(t, `{
+			"armor": "Armadura",
+			"shield": {"singular": "1 Escudo", "plural": "{{.Count}} Escudos"},
+			"sword": {"singular": "1 Espada", "description": "Arma"}
+		}`)}}
+
+		issues, err := Lint(defaults, targets, scanner.DefaultForms)
+		require.NoError(t, err)
+		require.Equal(t, []Issue{
+			{File: "en.json", Key: "shield", Kind: MissingPluralForm, Form: "none"},
+			{File: "pt.json", Key: "armor", Kind: MissingPluralForm, Form: "none"},
+			{File: "pt.json", Key: "armor", Kind: MissingPluralForm, Form: "plural"},
+			{File: "pt.json", Key: "armor", Kind: MissingPluralForm, Form: "singular"},
+		}, issues)
+	})
+
+	t.Run("custom forms", func(t *testing.T) {
+		defaults := []Catalog{{File: "en.json", Tree: tree(t, `{"armor": {"one": "1 Armor", "other": "{{.Count}} Armors", "zero": "No Armor"}}`)}}
+		targets := []Catalog{{File: "pt.json", Tree: tree(t, `{"armor": {"one": "1 Armadura", "other": ""}}`)}}
+
+		issues, err := Lint(defaults, targets, scanner.Forms{Singular: "one", Plural: "other", None: "zero"})
+		require.NoError(t, err)
+		require.Equal(t, []Issue{
+			{File: "pt.json", Key: "armor", Kind: EmptyValue, Form: "other"},
+			{File: "pt.json", Key: "armor", Kind: MissingPluralForm, Form: "zero"},
+		}, issues)
+	})
+
+	t.Run("default catalog issues", func(t *testing.T) {
+		issues, err := Lint([]Catalog{{File: "en.json", Tree: tree(t, `{"hello": ""}`)}}, nil, scanner.DefaultForms)
+		require.NoError(t, err)
+		require.Equal(t, []Issue{{File: "en.json", Key: "hello", Kind: EmptyValue}}, issues)
+	})
+}
@@ -0,0 +1,155 @@
+// Package metadata reads the metadata a message object carries next to its plural forms: notes for
+// translators and constraints on the translated text.
+package metadata
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+var ErrInvalidMetadata = errors.New("invalid metadata")
+
+// Keys of a message object holding metadata instead of text.
+const (
+	Description = "description"
+	Comment     = "comment"
+	MaxLength   = "maxLength"
+	Context     = "context"
+	Tags        = "tags"
+
+	// MessageContext tells apart messages with the same text when they are looked up by text, like
+	// msgctxt in gettext catalogs.
+	MessageContext = "msgctxt"
+)
+
+// IsKey reports whether key is one of the metadata keys.
+func IsKey(key string) bool {
+	switch key {
+	case Description, Comment, MaxLength, Context, Tags, MessageContext:
+		return true
+	default:
+		return false
+	}
+}
+
+// Metadata describes a message to translators and tools.
+type Metadata struct {
+	Description string   `json:"description,omitempty"` // What the message is for
+	Comment     string   `json:"comment,omitempty"`     // Notes for translators
+	MaxLength   int      `json:"maxLength,omitempty"`   // Maximum length of the translated text, 0 for none
+	Context     string   `json:"context,omitempty"`     // Where the message is shown, for translators
+	Tags        []string `json:"tags,omitempty"`
+
+	MessageContext string `json:"msgctxt,omitempty"` // Tells the message apart from others with its text
+}
+
+// Parse reads the metadata keys of a message object. Descriptions, comments and both contexts must
+// be strings, maxLength a non-negative integer and tags a list of strings.
+func Parse(object map[string]any) (Metadata, error) {
+	var m Metadata
+	var err error
+
+	for key, v := range object {
+		switch key {
+		case Description:
+			m.Description, err = text(key, v)
+		case Comment:
+			m.Comment, err = text(key, v)
+		case Context:
+			m.Context, err = text(key, v)
+		case MessageContext:
+			m.MessageContext, err = text(key, v)
+		case MaxLength:
+			m.MaxLength, err = length(v)
+		case Tags:
+			m.Tags, err = tags(v)
+		}
+
+		if err != nil {
+			return Metadata{}, err
+		}
+	}
+
+	return m, nil
+}
+
+func text(key string, v any) (string, error) {
+	s, ok := v.(string)
+	if !ok {
+		return "", fmt.Errorf("%w: %s must be a string: value: %v", ErrInvalidMetadata, key, v)
+	}
+
+	return s, nil
+}
+
+func length(v any) (int, error) {
+	var n float64
+	switch v := v.(type) {
+	case float64:
+		n = v
+	case int:
+		n = float64(v)
+	default:
+		return 0, fmt.Errorf("%w: %s must be a number: value: %v", ErrInvalidMetadata, MaxLength, v)
+	}
+
+	if n < 0 || n != math.Trunc(n) {
+		return 0, fmt.Errorf("%w: %s must be a non-negative integer: value: %v", ErrInvalidMetadata, MaxLength, v)
+	}
+
+	return int(n), nil
+}
+
+func tags(v any) ([]string, error) {
+	list, ok := v.([]any)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s must be a list: value: %v", ErrInvalidMetadata, Tags, v)
+	}
+
+	result := make([]string, 0, len(list))
+	for _, item := range list {
+		tag, ok := item.(string)
+		if !ok {
+			return nil, fmt.Errorf("%w: %s must hold strings: value: %v", ErrInvalidMetadata, Tags, item)
+		}
+
+		result = append(result, tag)
+	}
+
+	return result, nil
+}
+
+// Empty reports whether no metadata is set.
+func (m Metadata) Empty() bool {
+	return m.Description == "" && m.Comment == "" && m.MaxLength == 0 && m.Context == "" && len(m.Tags) == 0 &&
+		m.MessageContext == ""
+}
+
+// Notes returns the metadata as notes for translators, one per field that is set.
+func (m Metadata) Notes() []string {
+	var notes []string
+	if m.Description != "" {
+		notes = append(notes, m.Description)
+	}
+
+	if m.Comment != "" {
+		notes = append(notes, m.Comment)
+	}
+
+	if m.Context != "" {
+		notes = append(notes, "Context: "+m.Context)
+	}
+
+	if m.MaxLength > 0 {
+		notes = append(notes, "Maximum length: "+strconv.Itoa(m.MaxLength))
+	}
+
+	if len(m.Tags) > 0 {
+		notes = append(notes, "Tags: "+strings.Join(m.Tags, ", "))
+	}
+
+	return notes
+}
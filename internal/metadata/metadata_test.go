@@ -0,0 +1,77 @@
+package metadata
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	tt := []struct {
+		name     string
+		object   map[string]any
+		expected Metadata
+		err      error
+	}{
+		{
+			name: "every field",
+			object: map[string]any{
+				"singular":    "Armor",
+				"description": "Armor of the inventory",
+				"comment":     "Keep it short",
+				"maxLength":   20.0,
+				"context":     "inventory",
+				"tags":        []any{"items", "ui"},
+				"msgctxt":     "noun",
+			},
+			expected: Metadata{
+				Description: "Armor of the inventory",
+				Comment:     "Keep it short",
+				MaxLength:   20,
+				Context:     "inventory",
+				Tags:        []string{"items", "ui"},
+
+				MessageContext: "noun",
+			},
+		},
+		{name: "yaml integer", object: map[string]any{"maxLength": 12}, expected: Metadata{MaxLength: 12}},
+		{name: "no metadata", object: map[string]any{"singular": "Armor"}},
+		{name: "description not a string", object: map[string]any{"description": 1.0}, err: ErrInvalidMetadata},
+		{name: "fractional max length", object: map[string]any{"maxLength": 1.5}, err: ErrInvalidMetadata},
+		{name: "negative max length", object: map[string]any{"maxLength": -1.0}, err: ErrInvalidMetadata},
+		{name: "max length not a number", object: map[string]any{"maxLength": "20"}, err: ErrInvalidMetadata},
+		{name: "tags not a list", object: map[string]any{"tags": "items"}, err: ErrInvalidMetadata},
+		{name: "msgctxt not a string", object: map[string]any{"msgctxt": 1.0}, err: ErrInvalidMetadata},
+		{name: "tag not a string", object: map[string]any{"tags": []any{"items", 1.0}}, err: ErrInvalidMetadata},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			m, err := Parse(tc.object)
+			require.ErrorIs(t, err, tc.err)
+			require.Equal(t, tc.expected, m)
+		})
+	}
+}
+
+func TestMetadata_Notes(t *testing.T) {
+	m := Metadata{
+		Description: "Armor of the inventory",
+		Comment:     "Keep it short",
+		MaxLength:   20,
+		Context:     "inventory",
+		Tags:        []string{"items", "ui"},
+	}
+
+	require.False(t, m.Empty())
+	require.Equal(t, []string{
+		"Armor of the inventory",
+		"Keep it short",
+		"Context: inventory",
+		"Maximum length: 20",
+		"Tags: items, ui",
+	}, m.Notes())
+
+	require.True(t, Metadata{}.Empty())
+	require.Empty(t, Metadata{}.Notes())
+}
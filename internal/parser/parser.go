@@ -4,8 +4,8 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
-	"slices"
-	"strings"
+
+	"github.com/leoviggiano/gotr/internal/keypath"
 )
 
 var (
@@ -14,43 +14,47 @@ var (
 	ErrEmptyPath   = errors.New("empty path")
 )
 
+// Parse returns the JSON of the value at mapPath, a dot separated path whose keys may escape their
+// own dots as "\.", and whose segments index lists with numbers like "tips.1". Objects are returned
+// as they are and other values wrapped in an object under their key.
 func Parse(currentJSON any, mapPath string) ([]byte, error) {
-	currentPath := strings.Split(mapPath, ".")
+	currentPath := keypath.Split(mapPath)
 	if len(currentPath) == 0 || currentPath[0] == "" {
 		return nil, ErrEmptyPath
 	}
 
-	return parse(currentJSON, mapPath, currentPath[0])
+	return parse(currentJSON, mapPath, currentPath)
 }
 
-func parse(currentJSON any, mapPath, currentPath string) ([]byte, error) {
+func parse(currentJSON any, mapPath string, pathSlice []string) ([]byte, error) {
 	if currentJSON == nil {
-		return nil, fmt.Errorf("%w: mapPath: %s, currentPath: %s", ErrInvalidPath, mapPath, currentPath)
+		return nil, fmt.Errorf("%w: mapPath: %s, currentPath: %s", ErrInvalidPath, mapPath, pathSlice[0])
 	}
 
-	v, ok := currentJSON.(map[string]any)
-	if !ok {
+	var selectedJSON any
+	switch v := currentJSON.(type) {
+	case map[string]any:
+		selectedJSON = v[pathSlice[0]]
+	case []any:
+		if idx, ok := keypath.Index(pathSlice[0], len(v)); ok {
+			selectedJSON = v[idx]
+		}
+	default:
 		return nil, fmt.Errorf("%w: %v", ErrInvalidType, currentJSON)
 	}
 
-	pathSlice := strings.Split(mapPath, ".")
-	idx := slices.IndexFunc(pathSlice, func(i string) bool {
-		return i == currentPath
-	})
-
-	if idx == len(pathSlice)-1 {
-		selectedJSON := v[pathSlice[idx]]
-		if selectedJSON == nil {
-			return nil, fmt.Errorf("%w: mapPath: %s, currentPath: %s", ErrInvalidPath, mapPath, currentPath)
-		}
+	if len(pathSlice) > 1 {
+		return parse(selectedJSON, mapPath, pathSlice[1:])
+	}
 
-		switch v := selectedJSON.(type) {
-		case map[string]any:
-			return json.Marshal(v)
-		default:
-			return json.Marshal(map[string]any{pathSlice[idx]: v})
-		}
+	if selectedJSON == nil {
+		return nil, fmt.Errorf("%w: mapPath: %s, currentPath: %s", ErrInvalidPath, mapPath, pathSlice[0])
 	}
 
-	return parse(v[pathSlice[idx]], mapPath, pathSlice[idx+1])
+	switch v := selectedJSON.(type) {
+	case map[string]any:
+		return json.Marshal(v)
+	default:
+		return json.Marshal(map[string]any{pathSlice[0]: v})
+	}
 }
@@ -57,6 +57,10 @@ func TestParse(t *testing.T) {
 		{name: "1 - multiple levels path", currentJSON: multipleLevelsJSON, mapPath: "test"},
 		{name: "2 - multiple levels path", currentJSON: multipleLevelsJSON, mapPath: "test2.test"},
 		{name: "3 - multiple levels path", currentJSON: multipleLevelsJSON, mapPath: "test3.test2"},
+		{name: "escaped dot", currentJSON: map[string]any{"versions": map[string]any{"v1.2": map[string]any{"test": true}}}, mapPath: `versions.v1\.2`},
+		{name: "list index", currentJSON: map[string]any{"tips": []any{"a", map[string]any{"test": true}}}, mapPath: "tips.1"},
+		{name: "list index out of range", currentJSON: map[string]any{"tips": []any{"a"}}, mapPath: "tips.1", err: ErrInvalidPath},
+		{name: "invalid list index", currentJSON: map[string]any{"tips": []any{"a"}}, mapPath: "tips.first", err: ErrInvalidPath},
 		{name: "invalid path", currentJSON: oneLevelJSON, mapPath: "invalid", err: ErrInvalidPath},
 		{name: "invalid type", currentJSON: "invalid", mapPath: "test", err: ErrInvalidType},
 		{name: "empty path", err: ErrEmptyPath},
@@ -78,4 +82,10 @@ func TestParse(t *testing.T) {
 			require.Equal(t, expected, result)
 		})
 	}
+
+	t.Run("list element", func(t *testing.T) {
+		result, err := Parse(map[string]any{"tips": []any{"a", "b"}}, "tips.1")
+		require.NoError(t, err)
+		require.Equal(t, []byte(`{"1":"b"}`), result)
+	})
 }
@@ -0,0 +1,26 @@
+package placeholder
+
+import (
+	"regexp"
+	"sort"
+)
+
+var pattern = regexp.MustCompile(`\{\{\.([A-Za-z0-9_]+)\}\}`)
+
+// Names returns the sorted, deduplicated names of the {{.Name}} placeholders found in text.
+func Names(text string) []string {
+	seen := make(map[string]struct{})
+	names := []string{}
+
+	for _, match := range pattern.FindAllStringSubmatch(text, -1) {
+		if _, ok := seen[match[1]]; ok {
+			continue
+		}
+
+		seen[match[1]] = struct{}{}
+		names = append(names, match[1])
+	}
+
+	sort.Strings(names)
+	return names
+}
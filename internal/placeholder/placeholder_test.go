@@ -0,0 +1,26 @@
+package placeholder
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNames(t *testing.T) {
+	tt := []struct {
+		name     string
+		text     string
+		expected []string
+	}{
+		{name: "no placeholders", text: "Hello World", expected: []string{}},
+		{name: "single", text: "Hello {{.Name}}", expected: []string{"Name"}},
+		{name: "sorted and deduplicated", text: "{{.Name}} has {{.Count}} Armor, {{.Name}}.", expected: []string{"Count", "Name"}},
+		{name: "ignores malformed", text: "{{Name}} {{ .Name }} {.Name}", expected: []string{}},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, Names(tc.text))
+		})
+	}
+}
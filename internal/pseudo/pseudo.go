@@ -0,0 +1,101 @@
+package pseudo
+
+import (
+	"math"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/leoviggiano/gotr/internal/metadata"
+	"github.com/leoviggiano/gotr/internal/scanner"
+)
+
+// Expansion is the ratio a pseudo-localized text grows by, mimicking longer languages.
+const Expansion = 0.3
+
+var (
+	placeholderPattern = regexp.MustCompile(`\{\{[^}]*\}\}`)
+
+	accents = map[rune]rune{
+		'A': 'Å', 'B': 'Ɓ', 'C': 'Ç', 'D': 'Ð', 'E': 'É', 'F': 'Ƒ', 'G': 'Ĝ', 'H': 'Ĥ', 'I': 'Î',
+		'J': 'Ĵ', 'K': 'Ķ', 'L': 'Ļ', 'M': 'Ṁ', 'N': 'Ñ', 'O': 'Ö', 'P': 'Þ', 'Q': 'Ǫ', 'R': 'Ŕ',
+		'S': 'Š', 'T': 'Ţ', 'U': 'Û', 'V': 'Ṽ', 'W': 'Ŵ', 'X': 'Ẋ', 'Y': 'Ý', 'Z': 'Ž',
+		'a': 'å', 'b': 'ƀ', 'c': 'ç', 'd': 'ð', 'e': 'é', 'f': 'ƒ', 'g': 'ĝ', 'h': 'ĥ', 'i': 'î',
+		'j': 'ĵ', 'k': 'ķ', 'l': 'ļ', 'm': 'ṁ', 'n': 'ñ', 'o': 'ö', 'p': 'þ', 'q': 'ǫ', 'r': 'ŕ',
+		's': 'š', 't': 'ţ', 'u': 'û', 'v': 'ṽ', 'w': 'ŵ', 'x': 'ẋ', 'y': 'ý', 'z': 'ž',
+	}
+)
+
+// Localize accents every letter of text, pads it by Expansion and wraps it in brackets,
+// leaving the {{.Name}} placeholders untouched.
+func Localize(text string) string {
+	var b strings.Builder
+	visible := 0
+
+	b.WriteString("[")
+
+	last := 0
+	for _, loc := range placeholderPattern.FindAllStringIndex(text, -1) {
+		visible += accent(&b, text[last:loc[0]])
+		b.WriteString(text[loc[0]:loc[1]])
+		last = loc[1]
+	}
+	visible += accent(&b, text[last:])
+
+	padding := int(math.Ceil(float64(visible) * Expansion))
+	if padding > 0 {
+		b.WriteString(" ")
+		b.WriteString(strings.Repeat("~", padding))
+	}
+
+	b.WriteString("]")
+	return b.String()
+}
+
+func accent(b *strings.Builder, text string) int {
+	for _, r := range text {
+		if accented, ok := accents[r]; ok {
+			r = accented
+		}
+
+		b.WriteRune(r)
+	}
+
+	return utf8.RuneCountInString(text)
+}
+
+// Tree returns a copy of a catalog key tree with every text pseudo-localized, including the texts
+// of lists. The metadata keys of the plural blocks of forms are copied as they are.
+func Tree(tree map[string]any, forms scanner.Forms) map[string]any {
+	block := scanner.IsPluralBlock(tree, forms)
+
+	result := make(map[string]any, len(tree))
+	for k, v := range tree {
+		if block && metadata.IsKey(k) {
+			result[k] = v
+			continue
+		}
+
+		result[k] = value(v, forms)
+	}
+
+	return result
+}
+
+func value(v any, forms scanner.Forms) any {
+	switch v := v.(type) {
+	case map[string]any:
+		return Tree(v, forms)
+	case []any:
+		list := make([]any, len(v))
+		for i, item := range v {
+			list[i] = value(item, forms)
+		}
+
+		return list
+	case string:
+		return Localize(v)
+	default:
+		return v
+	}
+}
@@ -0,0 +1,50 @@
+package pseudo
+
+import (
+	"testing"
+
+	"github.com/leoviggiano/gotr/internal/scanner"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocalize(t *testing.T) {
+	tt := []struct {
+		name     string
+		text     string
+		expected string
+	}{
+		{name: "empty", text: "", expected: "[]"},
+		{name: "text", text: "Hello World", expected: "[Ĥéļļö Ŵöŕļð ~~~~]"},
+		{name: "placeholders", text: "{{.Name}} has {{.Count}} Armor.", expected: "[{{.Name}} ĥåš {{.Count}} Åŕṁöŕ. ~~~~]"},
+		{name: "non ascii", text: "Olá!", expected: "[Öļá! ~~]"},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, Localize(tc.text))
+		})
+	}
+}
+
+func TestTree(t *testing.T) {
+	tree := map[string]any{
+		"hello": "Hi",
+		"count": 10.0,
+		"texts": map[string]any{"bye": "Bye"},
+		"tips":  []any{"Hi", map[string]any{"bye": "Bye"}, []any{"Bye", true}},
+		"armor": map[string]any{"singular": "Hi", "description": "Bye", "tags": []any{"Hi"}},
+		"notes": map[string]any{"description": "Hi"},
+	}
+
+	require.Equal(t, map[string]any{
+		"hello": "[Ĥî ~]",
+		"count": 10.0,
+		"texts": map[string]any{"bye": "[Ɓýé ~]"},
+		"tips":  []any{"[Ĥî ~]", map[string]any{"bye": "[Ɓýé ~]"}, []any{"[Ɓýé ~]", true}},
+		"armor": map[string]any{"singular": "[Ĥî ~]", "description": "Bye", "tags": []any{"Hi"}},
+		"notes": map[string]any{"description": "[Ĥî ~]"},
+	}, Tree(tree, scanner.DefaultForms))
+
+	require.Equal(t, "Hi", tree["hello"])
+	require.Equal(t, "Hi", tree["tips"].([]any)[0])
+}
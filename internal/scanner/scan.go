@@ -1,55 +1,175 @@
 package scanner
 
 import (
+	"errors"
 	"fmt"
+	"sort"
+	"strconv"
 	"strings"
+
+	"github.com/leoviggiano/gotr/internal/keypath"
+	"github.com/leoviggiano/gotr/internal/metadata"
+)
+
+var (
+	ErrMalformedPlural = errors.New("malformed plural block")
+	ErrInvalidForms    = errors.New("invalid plural forms")
 )
 
-func Scan(currentJSON any) []string {
+// Forms names the keys of a plural block holding its singular, plural and none forms.
+type Forms struct {
+	Singular string
+	Plural   string
+	None     string
+}
+
+// DefaultForms are the form names of gotr catalogs.
+var DefaultForms = Forms{Singular: "singular", Plural: "plural", None: "none"}
+
+// Has reports whether key is exactly one of the form names.
+func (f Forms) Has(key string) bool {
+	return key == f.Singular || key == f.Plural || key == f.None
+}
+
+// Valid reports whether the form names are set and distinct.
+func (f Forms) Valid() bool {
+	return f.Singular != "" && f.Plural != "" && f.None != "" && f.Singular != f.Plural && f.Singular != f.None && f.Plural != f.None
+}
+
+// Name returns the key holding form, one of the names of DefaultForms, in the plural blocks of f.
+func (f Forms) Name(form string) string {
+	switch form {
+	case DefaultForms.Singular:
+		return f.Singular
+	case DefaultForms.Plural:
+		return f.Plural
+	case DefaultForms.None:
+		return f.None
+	default:
+		return form
+	}
+}
+
+func (f Forms) String() string {
+	return f.Singular + "," + f.Plural + "," + f.None
+}
+
+// ParseForms reads the singular, plural and none form names from a comma separated list, like
+// "one,other,zero".
+func ParseForms(s string) (Forms, error) {
+	names := strings.Split(s, ",")
+	if len(names) != 3 {
+		return Forms{}, fmt.Errorf("%w: %q", ErrInvalidForms, s)
+	}
+
+	forms := Forms{Singular: strings.TrimSpace(names[0]), Plural: strings.TrimSpace(names[1]), None: strings.TrimSpace(names[2])}
+	if !forms.Valid() {
+		return Forms{}, fmt.Errorf("%w: %q", ErrInvalidForms, s)
+	}
+
+	return forms, nil
+}
+
+// Scan returns the key paths of the leaves of currentJSON. An object with a leaf named after one of
+// forms is a plural block: its forms make up the single key path of the object, its metadata keys
+// are skipped and its other keys are scanned as usual. A plural block whose forms are not all texts
+// is malformed. The elements of lists are scanned under their index, like "tips.1".
+func Scan(currentJSON any, forms Forms) ([]string, error) {
 	paths := []string{}
 
-	for k, v := range currentJSON.(map[string]any) {
-		switch v := v.(type) {
-		case map[string]any:
-			newPaths := scan(v, k)
-			paths = append(paths, newPaths...)
-		default:
-			paths = append(paths, k)
+	tree := currentJSON.(map[string]any)
+	for _, k := range sortedKeys(tree) {
+		if _, ok := tree[k].(map[string]any); !ok && forms.Has(k) {
+			return nil, fmt.Errorf("%w: form %q at the top level has no key", ErrMalformedPlural, k)
 		}
+
+		newPaths, err := scanValue(tree[k], keypath.Escape(k), forms)
+		if err != nil {
+			return nil, err
+		}
+
+		paths = append(paths, newPaths...)
 	}
 
-	return paths
+	return paths, nil
 }
 
-func scan(currentJSON map[string]any, path string) []string {
-	mapPaths := make(map[string]struct{})
+// scanValue returns the key paths of the value at path.
+func scanValue(v any, path string, forms Forms) ([]string, error) {
+	switch v := v.(type) {
+	case map[string]any:
+		return scan(v, path, forms)
+	case []any:
+		return scanList(v, path, forms)
+	default:
+		return []string{path}, nil
+	}
+}
+
+func scan(currentJSON map[string]any, path string, forms Forms) ([]string, error) {
 	paths := []string{}
 
-	for k, v := range currentJSON {
-		newPath := fmt.Sprintf("%s.%s", path, k)
+	block := IsPluralBlock(currentJSON, forms)
+	if block {
+		paths = append(paths, path)
+	}
 
-		switch v := v.(type) {
-		case map[string]any:
-			newPaths := scan(v, fmt.Sprintf("%s.%s", path, k))
-			for _, p := range newPaths {
-				mapPaths[p] = struct{}{}
-			}
+	for _, k := range sortedKeys(currentJSON) {
+		v := currentJSON[k]
+		if block && metadata.IsKey(k) {
+			continue
+		}
 
-		default:
-			if strings.Contains(newPath, "singular") ||
-				strings.Contains(newPath, "plural") ||
-				strings.Contains(newPath, "none") {
-				mapPaths[path] = struct{}{}
-				continue
+		if block && forms.Has(k) {
+			if _, ok := v.(string); !ok {
+				return nil, fmt.Errorf("%w: %s: form %q is not a text", ErrMalformedPlural, path, k)
 			}
 
-			mapPaths[newPath] = struct{}{}
+			continue
 		}
+
+		newPaths, err := scanValue(v, path+"."+keypath.Escape(k), forms)
+		if err != nil {
+			return nil, err
+		}
+
+		paths = append(paths, newPaths...)
 	}
 
-	for k := range mapPaths {
-		paths = append(paths, k)
+	return paths, nil
+}
+
+func scanList(list []any, path string, forms Forms) ([]string, error) {
+	paths := []string{}
+	for i, v := range list {
+		newPaths, err := scanValue(v, path+"."+strconv.Itoa(i), forms)
+		if err != nil {
+			return nil, err
+		}
+
+		paths = append(paths, newPaths...)
+	}
+
+	return paths, nil
+}
+
+// IsPluralBlock reports whether an object holds any of forms as a leaf.
+func IsPluralBlock(object map[string]any, forms Forms) bool {
+	for k, v := range object {
+		if _, ok := v.(map[string]any); !ok && forms.Has(k) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func sortedKeys(object map[string]any) []string {
+	keys := make([]string, 0, len(object))
+	for k := range object {
+		keys = append(keys, k)
 	}
 
-	return paths
+	sort.Strings(keys)
+	return keys
 }
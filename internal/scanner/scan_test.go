@@ -42,7 +42,109 @@ func TestScan(t *testing.T) {
 		err := json.Unmarshal(testJSON, &currentJSON)
 		require.NoError(t, err)
 
-		paths := Scan(currentJSON)
+		paths, err := Scan(currentJSON, DefaultForms)
+		require.NoError(t, err)
 		require.ElementsMatch(t, expected, paths)
 	})
+	t.Run("keys with dots", func(t *testing.T) {
+		currentJSON := map[string]any{
+			"versions": map[string]any{"v1.2": "ok"},
+			"example.com": map[string]any{
+				"title": "ok",
+			},
+		}
+
+		paths, err := Scan(currentJSON, DefaultForms)
+		require.NoError(t, err)
+		require.ElementsMatch(t, []string{`versions.v1\.2`, `example\.com.title`}, paths)
+	})
+	t.Run("keys resembling forms", func(t *testing.T) {
+		currentJSON := map[string]any{
+			"errors": map[string]any{
+				"nonexistent":  "ok",
+				"plural_rules": "ok",
+			},
+			"singularity": map[string]any{"title": "ok"},
+		}
+
+		paths, err := Scan(currentJSON, DefaultForms)
+		require.NoError(t, err)
+		require.ElementsMatch(t, []string{"errors.nonexistent", "errors.plural_rules", "singularity.title"}, paths)
+	})
+
+	t.Run("form names as sections", func(t *testing.T) {
+		currentJSON := map[string]any{
+			"grammar": map[string]any{
+				"plural": map[string]any{"title": "ok"},
+			},
+		}
+
+		paths, err := Scan(currentJSON, DefaultForms)
+		require.NoError(t, err)
+		require.ElementsMatch(t, []string{"grammar.plural.title"}, paths)
+	})
+
+	t.Run("custom forms", func(t *testing.T) {
+		currentJSON := map[string]any{
+			"apple": map[string]any{"one": "ok", "other": "ok", "zero": "ok"},
+			"fruit": map[string]any{"singular": "ok"},
+		}
+
+		paths, err := Scan(currentJSON, Forms{Singular: "one", Plural: "other", None: "zero"})
+		require.NoError(t, err)
+		require.ElementsMatch(t, []string{"apple", "fruit.singular"}, paths)
+	})
+
+	t.Run("lists", func(t *testing.T) {
+		currentJSON := map[string]any{
+			"tips": []any{"ok", "ok"},
+			"banners": []any{
+				map[string]any{"title": "ok"},
+				map[string]any{"singular": "ok", "plural": "ok", "none": "ok"},
+			},
+			"empty": []any{},
+		}
+
+		paths, err := Scan(currentJSON, DefaultForms)
+		require.NoError(t, err)
+		require.ElementsMatch(t, []string{"tips.0", "tips.1", "banners.0.title", "banners.1"}, paths)
+	})
+
+	ttErrors := []struct {
+		name        string
+		currentJSON map[string]any
+	}{
+		{name: "form holding an object", currentJSON: map[string]any{
+			"apple": map[string]any{"singular": "ok", "plural": map[string]any{"value": "ok"}},
+		}},
+		{name: "form holding a number", currentJSON: map[string]any{
+			"apple": map[string]any{"singular": "ok", "plural": 2.0},
+		}},
+		{name: "form holding a list", currentJSON: map[string]any{
+			"apple": map[string]any{"singular": []any{"ok"}},
+		}},
+		{name: "form at the top level", currentJSON: map[string]any{"none": "ok"}},
+	}
+
+	for _, tc := range ttErrors {
+		t.Run(tc.name, func(t *testing.T) {
+			paths, err := Scan(tc.currentJSON, DefaultForms)
+			require.ErrorIs(t, err, ErrMalformedPlural)
+			require.Nil(t, paths)
+		})
+	}
+}
+
+func TestParseForms(t *testing.T) {
+	forms, err := ParseForms("one, other, zero")
+	require.NoError(t, err)
+	require.Equal(t, Forms{Singular: "one", Plural: "other", None: "zero"}, forms)
+	require.Equal(t, "one,other,zero", forms.String())
+	require.Equal(t, "other", forms.Name("plural"))
+	require.Equal(t, "description", forms.Name("description"))
+
+	for _, s := range []string{"", "one,other", "one,other,zero,many", "one,one,zero", "one,,zero"} {
+		_, err := ParseForms(s)
+		require.ErrorIs(t, err, ErrInvalidForms, s)
+	}
 }
@@ -0,0 +1,41 @@
+package gotr
+
+import "strconv"
+
+// GetList returns the rendered elements of the list at args.Localizer. The elements of a JSON array
+// are registered under their index, as "tips.0", "tips.1" and so on, and must be texts or plural
+// blocks. A locale without the list falls back to the default locale's, and an unknown list returns
+// nil.
+func (t *translator) GetList(args Args) []string {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	list := t.list(args.Identifier, args.Localizer)
+	if len(list) == 0 {
+		list = t.list(t.defaultIdentifier, args.Localizer)
+	}
+
+	if len(list) == 0 {
+		return nil
+	}
+
+	texts := make([]string, len(list))
+	for i, tpl := range list {
+		texts[i] = t.render(tpl, args)
+	}
+
+	return texts
+}
+
+// list returns the templates of the elements of the list at path, up to the first missing index.
+func (t *translator) list(identifier, path string) []template {
+	var list []template
+	for i := 0; ; i++ {
+		tpl, ok := t.find(identifier, path+"."+strconv.Itoa(i))
+		if !ok {
+			return list
+		}
+
+		list = append(list, tpl)
+	}
+}
@@ -0,0 +1,59 @@
+package gotr
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTranslator_GetList(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, dir, "en.json", `{
+		"onboarding": {
+			"tips": ["Welcome, {{.Name}}!", "Swipe to continue", {"singular": "{{.Count}} step left", "plural": "{{.Count}} steps left", "none": "Done"}]
+		},
+		"banners": ["Sale", "New"]
+	}`)
+	writeFile(t, dir, "pt.json", `{"onboarding": {"tips": [
+		"Bem-vindo, {{.Name}}!",
+		"Deslize para continuar",
+		{"singular": "Falta {{.Count}} passo", "plural": "Faltam {{.Count}} passos", "none": "Pronto"}
+	]}}`)
+
+	translator, err := NewTranslator(WithDefault("en", filepath.Join(dir, "en.json")))
+	require.NoError(t, err)
+
+	err = translator.Register("pt", filepath.Join(dir, "pt.json"))
+	require.NoError(t, err)
+
+	tt := []struct {
+		name     string
+		args     Args
+		expected []string
+	}{
+		{
+			name:     "success",
+			args:     Args{Identifier: "pt", Localizer: "onboarding.tips", Args: map[string]any{"Name": "John", "Count": 2}, Count: 2},
+			expected: []string{"Bem-vindo, John!", "Deslize para continuar", "Faltam 2 passos"},
+		},
+		{
+			name:     "fallback to default",
+			args:     Args{Identifier: "pt", Localizer: "banners"},
+			expected: []string{"Sale", "New"},
+		},
+		{name: "unknown list", args: Args{Identifier: "pt", Localizer: "missing"}},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, translator.GetList(tc.args))
+		})
+	}
+
+	t.Run("indexed paths", func(t *testing.T) {
+		require.Equal(t, "Deslize para continuar", translator.Get(Args{Identifier: "pt", Localizer: "onboarding.tips.1"}))
+		require.Equal(t, "Pronto", translator.Get(Args{Identifier: "pt", Localizer: "onboarding.tips.2"}))
+		require.Equal(t, "New", translator.Get(Args{Identifier: "pt", Localizer: "banners.1"}))
+	})
+}
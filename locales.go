@@ -0,0 +1,80 @@
+package gotr
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/leoviggiano/gotr/internal/placeholder"
+)
+
+// TemplateInfo describes a registered template: its key path, its plural forms, the placeholders
+// they use and its metadata.
+type TemplateInfo struct {
+	Key          string   `json:"key"`
+	Singular     string   `json:"singular"`
+	Plural       string   `json:"plural"`
+	None         string   `json:"none"`
+	Placeholders []string `json:"placeholders"` // Sorted names used by any of the forms
+	Metadata     Metadata `json:"metadata"`     // Metadata of the template, or of the default locale's one
+}
+
+// Locales returns the sorted identifiers of the registered locales, the pseudo locale included.
+func (t *translator) Locales() []string {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	locales := make([]string, 0, len(t.templates))
+	for identifier := range t.templates {
+		locales = append(locales, identifier)
+	}
+	sort.Strings(locales)
+
+	return locales
+}
+
+// Default returns the identifier of the default locale.
+func (t *translator) Default() string {
+	return t.defaultIdentifier
+}
+
+// Keys returns the sorted key paths registered for identifier, leaving out the text-as-key entries.
+func (t *translator) Keys(identifier string) []string {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	keys := []string{}
+	for key, tpl := range t.templates[identifier] {
+		if tpl.path == key {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	return keys
+}
+
+// Template returns the template registered for identifier under key, a key path or a text, without
+// falling back to the default locale.
+func (t *translator) Template(identifier, key string) (TemplateInfo, bool) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	tpl, ok := t.templates[identifier][key]
+	if !ok {
+		return TemplateInfo{}, false
+	}
+
+	meta := tpl.metadata
+	if defaultTemplate, ok := t.templates[t.defaultIdentifier][tpl.path]; ok && meta.Empty() {
+		meta = defaultTemplate.metadata
+	}
+
+	return TemplateInfo{
+		Key:          tpl.path,
+		Singular:     tpl.Singular,
+		Plural:       tpl.Plural,
+		None:         tpl.None,
+		Placeholders: placeholder.Names(strings.Join([]string{tpl.Singular, tpl.Plural, tpl.None}, "\n")),
+		Metadata:     meta,
+	}, true
+}
@@ -0,0 +1,58 @@
+package gotr
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTranslator_Locales(t *testing.T) {
+	translator, err := NewTranslator(
+		WithDefault("en", "./translations/en_US.json"),
+		WithDefault("en", "./translations/en_US_items.json"),
+		WithPseudoLocale("en-XA"),
+	)
+	require.NoError(t, err)
+
+	err = translator.Register("pt", "./translations/pt_BR.json")
+	require.NoError(t, err)
+
+	require.Equal(t, []string{"en", "en-XA", "pt"}, translator.Locales())
+	require.Equal(t, "en", translator.Default())
+
+	t.Run("keys", func(t *testing.T) {
+		require.Equal(t, []string{
+			"hello_world",
+			"items.consumables.health-potion",
+			"items.consumables.mana-potion",
+			"items.equipments.armor",
+			"texts.goodbye",
+			"texts.welcome",
+		}, translator.Keys("pt"))
+
+		require.Equal(t, []string{}, translator.Keys("missing"))
+	})
+
+	t.Run("template", func(t *testing.T) {
+		expected := TemplateInfo{
+			Key:          "items.equipments.armor",
+			Singular:     "{{.Name}} tem {{.Count}} Armadura.",
+			Plural:       "{{.Name}} tem {{.Count}} Armaduras.",
+			None:         "{{.Name}} não tem Armadura.",
+			Placeholders: []string{"Count", "Name"},
+			Metadata:     Metadata{Description: "Armadura texto"},
+		}
+
+		tpl, ok := translator.Template("pt", "items.equipments.armor")
+		require.True(t, ok)
+		require.Equal(t, expected, tpl)
+
+		tpl, ok = translator.Template("pt", "{{.Name}} has {{.Count}} Armor.")
+		require.True(t, ok)
+		require.Equal(t, expected, tpl)
+
+		tpl, ok = translator.Template("pt", "hello_world2")
+		require.False(t, ok)
+		require.Equal(t, TemplateInfo{}, tpl)
+	})
+}
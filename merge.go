@@ -0,0 +1,101 @@
+package gotr
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+)
+
+// MergePolicy decides what happens when a file registers a key path another file already registered
+// for the same identifier.
+type MergePolicy int
+
+const (
+	MergeLastWins  MergePolicy = iota // The later file replaces the key
+	MergeFirstWins                    // The later file's key is ignored
+	MergeError                        // Register fails without registering anything
+)
+
+var (
+	errKeyConflict = errors.New("key conflict")
+)
+
+// Conflict is a key path registered by two files for the same identifier.
+type Conflict struct {
+	Identifier string `json:"identifier"`
+	Key        string `json:"key"`
+	Existing   string `json:"existing"` // File the key was registered from first, empty for readers
+	Incoming   string `json:"incoming"` // File registering the key again, empty for readers
+}
+
+func (c Conflict) String() string {
+	if c.Existing == "" && c.Incoming == "" {
+		return fmt.Sprintf("%s: %s is defined in two readers", c.Identifier, c.Key)
+	}
+
+	return fmt.Sprintf("%s: %s is defined in %s and %s", c.Identifier, c.Key, sourceName(c.Existing), sourceName(c.Incoming))
+}
+
+func sourceName(source string) string {
+	if source == "" {
+		return "a reader"
+	}
+
+	return source
+}
+
+// WithMergePolicy sets how conflicting keys are merged; the default is MergeLastWins. It must come
+// before the options registering files.
+func WithMergePolicy(policy MergePolicy) option {
+	return func(t *translator) error {
+		if len(t.templates) > 0 {
+			return fmt.Errorf("%w: WithMergePolicy", errOptionAfterFiles)
+		}
+
+		t.mergePolicy = policy
+		return nil
+	}
+}
+
+// Conflicts returns the key conflicts found by the registrations so far, whatever the merge policy.
+func (t *translator) Conflicts() []Conflict {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	return append([]Conflict{}, t.conflicts...)
+}
+
+// findConflicts returns the keys of tpls already registered for identifier from another file or a
+// reader.
+func (t *translator) findConflicts(identifier string, tpls []template) []Conflict {
+	var conflicts []Conflict
+	for _, tpl := range tpls {
+		// A file may be registered again, but every reader is a source of its own.
+		existing, ok := t.templates[identifier][tpl.path]
+		if !ok || existing.path != tpl.path || existing.source != "" && existing.source == tpl.source {
+			continue
+		}
+
+		conflicts = append(conflicts, Conflict{
+			Identifier: identifier,
+			Key:        tpl.path,
+			Existing:   existing.source,
+			Incoming:   tpl.source,
+		})
+	}
+
+	sort.Slice(conflicts, func(i, j int) bool {
+		return conflicts[i].Key < conflicts[j].Key
+	})
+
+	return conflicts
+}
+
+func conflictError(conflicts []Conflict) error {
+	errs := make([]error, len(conflicts))
+	for i, c := range conflicts {
+		errs[i] = fmt.Errorf("%w: %s", errKeyConflict, c)
+	}
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,96 @@
+package gotr
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithMergePolicy(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, dir, "en_US.json", `{"hello_world": "Hello World", "texts": {"welcome": "Welcome!"}}`)
+	writeFile(t, dir, "en_US_items.json", `{"hello_world": "Hello Items", "items": {"armor": "Armor"}}`)
+	first := filepath.Join(dir, "en_US.json")
+	second := filepath.Join(dir, "en_US_items.json")
+
+	conflicts := []Conflict{{Identifier: "en", Key: "hello_world", Existing: first, Incoming: second}}
+
+	tt := []struct {
+		name     string
+		policy   MergePolicy
+		expected string
+	}{
+		{name: "last wins", policy: MergeLastWins, expected: "Hello Items"},
+		{name: "first wins", policy: MergeFirstWins, expected: "Hello World"},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			translator, err := NewTranslator(
+				WithMergePolicy(tc.policy),
+				WithDefault("en", first),
+				WithDefault("en", second),
+			)
+			require.NoError(t, err)
+
+			require.Equal(t, tc.expected, translator.Get(Args{Identifier: "en", Localizer: "hello_world"}))
+			require.Equal(t, "Armor", translator.Get(Args{Identifier: "en", Localizer: "items.armor"}))
+			require.Equal(t, conflicts, translator.Conflicts())
+		})
+	}
+
+	t.Run("error", func(t *testing.T) {
+		translator, err := NewTranslator(WithMergePolicy(MergeError), WithDefault("en", first))
+		require.NoError(t, err)
+
+		err = translator.Register("en", second)
+		require.ErrorIs(t, err, errKeyConflict)
+		require.EqualError(t, err, "key conflict: en: hello_world is defined in "+first+" and "+second)
+
+		require.Equal(t, "Hello World", translator.Get(Args{Identifier: "en", Localizer: "hello_world"}))
+		require.Equal(t, "items.armor", translator.Get(Args{Identifier: "en", Localizer: "items.armor"}))
+		require.Empty(t, translator.Conflicts())
+	})
+
+	t.Run("same file registered again", func(t *testing.T) {
+		translator, err := NewTranslator(WithMergePolicy(MergeError), WithDefault("en", first), WithDefault("en", first))
+		require.NoError(t, err)
+		require.Empty(t, translator.Conflicts())
+	})
+
+	t.Run("after a file option", func(t *testing.T) {
+		translator, err := NewTranslator(WithDefault("en", first), WithMergePolicy(MergeError))
+		require.ErrorIs(t, err, errOptionAfterFiles)
+		require.Nil(t, translator)
+	})
+
+	t.Run("readers and other identifiers", func(t *testing.T) {
+		translator, err := NewTranslator(WithDefault("en", first))
+		require.NoError(t, err)
+
+		err = translator.RegisterYAML("pt", strings.NewReader("hello_world: Olá Mundo"))
+		require.NoError(t, err)
+
+		err = translator.RegisterYAML("en", strings.NewReader("texts:\n  welcome: Welcome back!"))
+		require.NoError(t, err)
+
+		require.Equal(t, []Conflict{{Identifier: "en", Key: "texts.welcome", Existing: first}}, translator.Conflicts())
+		require.Equal(t, "en: texts.welcome is defined in "+first+" and a reader", translator.Conflicts()[0].String())
+	})
+
+	t.Run("two readers", func(t *testing.T) {
+		translator, err := NewTranslator(WithMergePolicy(MergeError))
+		require.NoError(t, err)
+
+		err = translator.RegisterYAML("en", strings.NewReader("hello_world: Hello World"))
+		require.NoError(t, err)
+
+		err = translator.RegisterYAML("en", strings.NewReader("hello_world: Hello Items"))
+		require.ErrorIs(t, err, errKeyConflict)
+		require.EqualError(t, err, "key conflict: en: hello_world is defined in two readers")
+
+		require.Equal(t, "Hello World", translator.Get(Args{Identifier: "en", Localizer: "hello_world"}))
+	})
+}
@@ -0,0 +1,9 @@
+package gotr
+
+import "github.com/leoviggiano/gotr/internal/metadata"
+
+// Metadata is what a message object carries next to its forms for translators and tools: its
+// "description", "comment", "maxLength", "context" and "tags" keys, and the "msgctxt" telling it
+// apart from messages with the same text. Metadata keys are never registered as keys of their own,
+// and exports write them as notes for translators.
+type Metadata = metadata.Metadata
@@ -0,0 +1,109 @@
+package gotr
+
+import (
+	"errors"
+	"sort"
+	"strings"
+)
+
+// namespaceSeparator separates the namespace from the key path, as in "inventory:equipments.armor".
+const namespaceSeparator = ":"
+
+var (
+	errInvalidNamespace = errors.New("namespace must not contain " + namespaceSeparator)
+)
+
+// WithNamespace registers the keys of a file under namespace, so they are addressed as
+// "namespace:path" and don't collide with the keys of other files.
+func WithNamespace(namespace string) registerOption {
+	return func(r *registration) {
+		r.namespace = namespace
+	}
+}
+
+// WithFallbackNamespace sets the namespaces a key is looked up in, in order, when it is missing
+// from the namespace it was asked for, or when it was asked for without one.
+func WithFallbackNamespace(namespaces ...string) option {
+	return func(t *translator) error {
+		for _, namespace := range namespaces {
+			if namespace == "" || strings.Contains(namespace, namespaceSeparator) {
+				return errInvalidNamespace
+			}
+		}
+
+		t.fallbackNamespaces = namespaces
+		return nil
+	}
+}
+
+// find looks localizer up in the templates of identifier and then, without its namespace, in the
+// fallback namespaces.
+func (t *translator) find(identifier, localizer string) (template, bool) {
+	templates := t.templates[identifier]
+	if tpl, ok := templates[localizer]; ok {
+		return tpl, true
+	}
+
+	if len(t.fallbackNamespaces) == 0 {
+		return template{}, false
+	}
+
+	key := localizer
+	if namespace, path, ok := strings.Cut(localizer, namespaceSeparator); ok && t.isNamespace(namespace) {
+		key = path
+	}
+
+	for _, namespace := range t.fallbackNamespaces {
+		if tpl, ok := templates[namespace+namespaceSeparator+key]; ok {
+			return tpl, true
+		}
+	}
+
+	return template{}, false
+}
+
+func (t *translator) isNamespace(namespace string) bool {
+	for _, namespaces := range t.namespaces {
+		if _, ok := namespaces[namespace]; ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Namespaces returns the sorted namespaces registered for identifier.
+func (t *translator) Namespaces(identifier string) []string {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	namespaces := make([]string, 0, len(t.namespaces[identifier]))
+	for namespace := range t.namespaces[identifier] {
+		namespaces = append(namespaces, namespace)
+	}
+	sort.Strings(namespaces)
+
+	return namespaces
+}
+
+// UnloadNamespace removes the keys registered under namespace for identifier, along with the texts
+// aliasing them, in every locale when identifier is the default.
+func (t *translator) UnloadNamespace(identifier, namespace string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if _, ok := t.namespaces[identifier][namespace]; !ok {
+		return
+	}
+
+	prefix := namespace + namespaceSeparator
+	for key, tpl := range t.templates[identifier] {
+		if strings.HasPrefix(tpl.path, prefix) {
+			delete(t.templates[identifier], key)
+		}
+	}
+
+	delete(t.namespaces[identifier], namespace)
+
+	t.relink(identifier)
+}
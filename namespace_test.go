@@ -0,0 +1,73 @@
+package gotr
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithNamespace(t *testing.T) {
+	translator, err := NewTranslator(
+		WithDefault("en", "./translations/en_US.json"),
+		WithFallbackNamespace("common"),
+	)
+	require.NoError(t, err)
+
+	err = translator.RegisterYAML("en", strings.NewReader("equipments:\n  armor: Armor\nhello_world: Hello Inventory"), WithNamespace("inventory"))
+	require.NoError(t, err)
+
+	err = translator.RegisterYAML("en", strings.NewReader("goodbye: Goodbye!\nhello_world: Hello Common"), WithNamespace("common"))
+	require.NoError(t, err)
+
+	err = translator.RegisterYAML("pt", strings.NewReader("equipments:\n  armor: Armadura"), WithNamespace("inventory"))
+	require.NoError(t, err)
+
+	err = translator.RegisterYAML("pt", strings.NewReader("goodbye: Tchau!"), WithNamespace("common"))
+	require.NoError(t, err)
+
+	tt := []struct {
+		name     string
+		args     Args
+		expected string
+	}{
+		{name: "namespaced key", args: Args{Identifier: "pt", Localizer: "inventory:equipments.armor"}, expected: "Armadura"},
+		{name: "keys don't collide", args: Args{Identifier: "en", Localizer: "inventory:hello_world"}, expected: "Hello Inventory"},
+		{name: "key without namespace", args: Args{Identifier: "en", Localizer: "hello_world"}, expected: "Hello World"},
+		{name: "namespaced text", args: Args{Identifier: "pt", Localizer: "Armor"}, expected: "Armadura"},
+		{name: "default locale", args: Args{Identifier: "pt", Localizer: "inventory:hello_world"}, expected: "Hello Inventory"},
+		{name: "fallback namespace", args: Args{Identifier: "pt", Localizer: "inventory:goodbye"}, expected: "Tchau!"},
+		{name: "fallback namespace without namespace", args: Args{Identifier: "pt", Localizer: "goodbye"}, expected: "Tchau!"},
+		{name: "fallback namespace of the default locale", args: Args{Identifier: "es", Localizer: "inventory:goodbye"}, expected: "Goodbye!"},
+		{name: "unknown namespace", args: Args{Identifier: "pt", Localizer: "shop:goodbye"}, expected: "shop:goodbye"},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, translator.Get(tc.args))
+		})
+	}
+
+	t.Run("list", func(t *testing.T) {
+		require.Equal(t, []string{"common", "inventory"}, translator.Namespaces("en"))
+		require.Equal(t, []string{}, translator.Namespaces("es"))
+	})
+
+	t.Run("unload", func(t *testing.T) {
+		translator.UnloadNamespace("pt", "inventory")
+		translator.UnloadNamespace("pt", "missing")
+
+		require.Equal(t, []string{"common"}, translator.Namespaces("pt"))
+		require.Equal(t, "Armor", translator.Get(Args{Identifier: "pt", Localizer: "inventory:equipments.armor"}))
+		require.Equal(t, "Armor", translator.Get(Args{Identifier: "pt", Localizer: "Armor"}))
+		require.Equal(t, "Tchau!", translator.Get(Args{Identifier: "pt", Localizer: "goodbye"}))
+	})
+
+	t.Run("invalid namespace", func(t *testing.T) {
+		err := translator.Register("en", "./translations/en_US_items.json", WithNamespace("a:b"))
+		require.ErrorIs(t, err, errInvalidNamespace)
+
+		_, err = NewTranslator(WithFallbackNamespace(""))
+		require.ErrorIs(t, err, errInvalidNamespace)
+	})
+}
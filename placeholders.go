@@ -0,0 +1,188 @@
+package gotr
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/leoviggiano/gotr/internal/catalog"
+	"github.com/leoviggiano/gotr/internal/placeholder"
+	"github.com/leoviggiano/gotr/internal/scanner"
+)
+
+type PlaceholderIssueKind string
+
+const (
+	PlaceholderMissing    PlaceholderIssueKind = "missing"
+	PlaceholderExtra      PlaceholderIssueKind = "extra"
+	PlaceholderMisspelled PlaceholderIssueKind = "misspelled"
+)
+
+// PlaceholderIssue describes a placeholder that differs from the default locale's template.
+type PlaceholderIssue struct {
+	Identifier  string               `json:"identifier"`
+	Key         string               `json:"key"`
+	Form        string               `json:"form,omitempty"` // Named by WithPluralForms, empty when neither template has plural forms
+	Kind        PlaceholderIssueKind `json:"kind"`
+	Placeholder string               `json:"placeholder"`        // Placeholder found in, or missing from, the translation
+	Expected    string               `json:"expected,omitempty"` // Placeholder of the default locale a misspelled one stands for
+}
+
+// ValidatePlaceholders compares the placeholders of every registered template against the template
+// with the same key in the default locale, form by form.
+func (t *translator) ValidatePlaceholders() []PlaceholderIssue {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	issues := []PlaceholderIssue{}
+
+	defaultTemplates, ok := t.templates[t.defaultIdentifier]
+	if !ok {
+		return issues
+	}
+
+	for identifier, templates := range t.templates {
+		if identifier == t.defaultIdentifier {
+			continue
+		}
+
+		for key, tpl := range templates {
+			if tpl.path != key {
+				continue
+			}
+
+			defaultTemplate, ok := defaultTemplates[key]
+			if !ok || defaultTemplate.path != key {
+				continue
+			}
+
+			issues = append(issues, comparePlaceholders(identifier, key, defaultTemplate, tpl, t.pluralForms())...)
+		}
+	}
+
+	sort.Slice(issues, func(i, j int) bool {
+		a, b := issues[i], issues[j]
+		if a.Identifier != b.Identifier {
+			return a.Identifier < b.Identifier
+		}
+
+		if a.Key != b.Key {
+			return a.Key < b.Key
+		}
+
+		if a.Form != b.Form {
+			return a.Form < b.Form
+		}
+
+		return a.Placeholder < b.Placeholder
+	})
+
+	return issues
+}
+
+func comparePlaceholders(identifier, key string, expected, actual template, forms scanner.Forms) []PlaceholderIssue {
+	if expected.plain() && actual.plain() {
+		return compareForm(identifier, key, "", expected.Singular, actual.Singular)
+	}
+
+	var issues []PlaceholderIssue
+	for _, form := range catalog.PluralForms {
+		issues = append(issues, compareForm(identifier, key, forms.Name(form), expected.forms().Get(form), actual.forms().Get(form))...)
+	}
+
+	return issues
+}
+
+func compareForm(identifier, key, form, expected, actual string) []PlaceholderIssue {
+	missing := difference(placeholder.Names(expected), placeholder.Names(actual))
+	extra := difference(placeholder.Names(actual), placeholder.Names(expected))
+
+	var issues []PlaceholderIssue
+	newIssue := func(kind PlaceholderIssueKind, name, expected string) {
+		issues = append(issues, PlaceholderIssue{
+			Identifier:  identifier,
+			Key:         key,
+			Form:        form,
+			Kind:        kind,
+			Placeholder: name,
+			Expected:    expected,
+		})
+	}
+
+	for _, name := range extra {
+		idx := closest(name, missing)
+		if idx < 0 {
+			newIssue(PlaceholderExtra, name, "")
+			continue
+		}
+
+		newIssue(PlaceholderMisspelled, name, missing[idx])
+		missing = append(missing[:idx], missing[idx+1:]...)
+	}
+
+	for _, name := range missing {
+		newIssue(PlaceholderMissing, name, "")
+	}
+
+	return issues
+}
+
+func difference(a, b []string) []string {
+	set := make(map[string]struct{}, len(b))
+	for _, v := range b {
+		set[v] = struct{}{}
+	}
+
+	result := []string{}
+	for _, v := range a {
+		if _, ok := set[v]; !ok {
+			result = append(result, v)
+		}
+	}
+
+	return result
+}
+
+// closest returns the index of the candidate that name is most likely a misspelling of, or -1.
+func closest(name string, candidates []string) int {
+	idx, best := -1, 0
+	for i, candidate := range candidates {
+		maxDistance := min(len(name), len(candidate)) / 3
+		if maxDistance < 1 {
+			maxDistance = 1
+		}
+
+		d := distance(name, candidate)
+		if d <= maxDistance && (idx < 0 || d < best) {
+			idx, best = i, d
+		}
+	}
+
+	return idx
+}
+
+// distance is the Levenshtein distance between a and b, ignoring case.
+func distance(a, b string) int {
+	ra, rb := []rune(strings.ToLower(a)), []rune(strings.ToLower(b))
+	prev := make([]int, len(rb)+1)
+	curr := make([]int, len(rb)+1)
+
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(ra); i++ {
+		curr[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+
+		prev, curr = curr, prev
+	}
+
+	return prev[len(rb)]
+}
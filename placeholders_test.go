@@ -0,0 +1,79 @@
+package gotr
+
+import (
+	"testing"
+
+	"github.com/leoviggiano/gotr/internal/scanner"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTranslator_ValidatePlaceholders(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		translator, err := NewTranslator(
+			WithDefault("en", "./translations/en_US.json"),
+			WithDefault("en", "./translations/en_US_items.json"),
+		)
+		require.NoError(t, err)
+
+		err = translator.Register("pt", "./translations/pt_BR.json")
+		require.NoError(t, err)
+
+		require.Empty(t, translator.ValidatePlaceholders())
+	})
+
+	t.Run("issues", func(t *testing.T) {
+		armor := template{
+			Singular: "{{.Name}} has {{.Count}} Armor.",
+			Plural:   "{{.Name}} has {{.Count}} Armors.",
+			None:     "{{.Name}} has no Armor.",
+			path:     "armor",
+		}
+		welcome := template{Singular: "Welcome {{.Name}}", Plural: "Welcome {{.Name}}", None: "Welcome {{.Name}}", path: "welcome"}
+
+		translator := &translator{
+			defaultIdentifier: "en",
+			templates: map[string]map[string]template{
+				"en": {"armor": armor, "welcome": welcome, welcome.Singular: welcome},
+				"pt": {
+					"armor": {
+						Singular: "{{.name}} tem {{.Count}} Armadura.",
+						Plural:   "{{.Name}} tem Armaduras.",
+						None:     "{{.Name}} não tem {{.Item}}.",
+						path:     "armor",
+					},
+					"welcome":   {Singular: "Bem-vindo", Plural: "Bem-vindo", None: "Bem-vindo", path: "welcome"},
+					"Bem-vindo": {Singular: "Bem-vindo", Plural: "Bem-vindo", None: "Bem-vindo", path: "welcome"},
+				},
+			},
+		}
+
+		expected := []PlaceholderIssue{
+			{Identifier: "pt", Key: "armor", Form: "none", Kind: PlaceholderExtra, Placeholder: "Item"},
+			{Identifier: "pt", Key: "armor", Form: "plural", Kind: PlaceholderMissing, Placeholder: "Count"},
+			{Identifier: "pt", Key: "armor", Form: "singular", Kind: PlaceholderMisspelled, Placeholder: "name", Expected: "Name"},
+			{Identifier: "pt", Key: "welcome", Kind: PlaceholderMissing, Placeholder: "Name"},
+		}
+
+		require.Equal(t, expected, translator.ValidatePlaceholders())
+	})
+
+	t.Run("custom forms", func(t *testing.T) {
+		translator := &translator{
+			defaultIdentifier: "en",
+			forms:             scanner.Forms{Singular: "one", Plural: "other", None: "zero"},
+			templates: map[string]map[string]template{
+				"en": {"armor": {Singular: "{{.Count}} Armor", Plural: "{{.Count}} Armors", None: "No Armor", path: "armor"}},
+				"pt": {"armor": {Singular: "{{.Count}} Armadura", Plural: "Armaduras", None: "Sem Armadura", path: "armor"}},
+			},
+		}
+
+		require.Equal(t, []PlaceholderIssue{
+			{Identifier: "pt", Key: "armor", Form: "other", Kind: PlaceholderMissing, Placeholder: "Count"},
+		}, translator.ValidatePlaceholders())
+	})
+
+	t.Run("no default", func(t *testing.T) {
+		translator := &translator{templates: make(map[string]map[string]template)}
+		require.Empty(t, translator.ValidatePlaceholders())
+	})
+}
@@ -0,0 +1,41 @@
+package gotr
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/leoviggiano/gotr/internal/scanner"
+)
+
+var (
+	errInvalidPluralForms = errors.New("invalid plural forms")
+	errOptionAfterFiles   = errors.New("option must come before the options registering files")
+)
+
+// WithPluralForms sets the keys of the plural blocks holding the singular, plural and none forms of a
+// template, "singular", "plural" and "none" by default. The names must be distinct and it must come
+// before the options registering files.
+func WithPluralForms(singular, plural, none string) option {
+	return func(t *translator) error {
+		forms := scanner.Forms{Singular: singular, Plural: plural, None: none}
+		if !forms.Valid() {
+			return fmt.Errorf("%w: %q, %q and %q", errInvalidPluralForms, singular, plural, none)
+		}
+
+		if len(t.templates) > 0 {
+			return fmt.Errorf("%w: WithPluralForms", errOptionAfterFiles)
+		}
+
+		t.forms = forms
+		return nil
+	}
+}
+
+// pluralForms returns the form names set by WithPluralForms, or the default ones.
+func (t *translator) pluralForms() scanner.Forms {
+	if t.forms == (scanner.Forms{}) {
+		return scanner.DefaultForms
+	}
+
+	return t.forms
+}
@@ -0,0 +1,84 @@
+package gotr
+
+import (
+	"bytes"
+	"path/filepath"
+	"testing"
+
+	"github.com/leoviggiano/gotr/internal/scanner"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithPluralForms(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		dir := t.TempDir()
+		writeFile(t, dir, "en.json", `{"apple": {"one": "An apple", "other": "Apples", "zero": "No apples"}}`)
+
+		translator, err := NewTranslator(
+			WithPluralForms("one", "other", "zero"),
+			WithDefault("en", filepath.Join(dir, "en.json")),
+		)
+		require.NoError(t, err)
+
+		for count, expected := range map[int]string{0: "No apples", 1: "An apple", 3: "Apples"} {
+			require.Equal(t, expected, translator.Get(Args{Identifier: "en", Localizer: "apple", Count: count}))
+		}
+
+		var buf bytes.Buffer
+		err = translator.ExportCSV(&buf)
+		require.NoError(t, err)
+		require.Equal(t, "key,en\napple.one,An apple\napple.other,Apples\napple.zero,No apples\n", buf.String())
+	})
+
+	t.Run("after a file option", func(t *testing.T) {
+		translator, err := NewTranslator(
+			WithDefault("en", "./translations/en_US.json"),
+			WithPluralForms("one", "other", "zero"),
+		)
+		require.ErrorIs(t, err, errOptionAfterFiles)
+		require.Nil(t, translator)
+	})
+
+	ttErrors := []struct {
+		name     string
+		singular string
+		plural   string
+		none     string
+	}{
+		{name: "empty name", singular: "one", plural: "", none: "zero"},
+		{name: "duplicated name", singular: "one", plural: "other", none: "other"},
+	}
+
+	for _, tc := range ttErrors {
+		t.Run(tc.name, func(t *testing.T) {
+			translator, err := NewTranslator(WithPluralForms(tc.singular, tc.plural, tc.none))
+			require.ErrorIs(t, err, errInvalidPluralForms)
+			require.Nil(t, translator)
+		})
+	}
+}
+
+func TestTranslator_RegisterPluralBlocks(t *testing.T) {
+	t.Run("keys resembling forms", func(t *testing.T) {
+		dir := t.TempDir()
+		writeFile(t, dir, "en.json", `{"errors": {"nonexistent": "Not found", "plural_rules": "Rules"}}`)
+
+		translator, err := NewTranslator(WithDefault("en", filepath.Join(dir, "en.json")))
+		require.NoError(t, err)
+
+		require.Equal(t, []string{"errors.nonexistent", "errors.plural_rules"}, translator.Keys("en"))
+		require.Equal(t, "Not found", translator.Get(Args{Identifier: "en", Localizer: "errors.nonexistent"}))
+	})
+
+	t.Run("malformed block", func(t *testing.T) {
+		dir := t.TempDir()
+		writeFile(t, dir, "en.json", `{"apple": {"singular": "An apple", "plural": {"text": "Apples"}}}`)
+
+		translator, err := NewTranslator()
+		require.NoError(t, err)
+
+		err = translator.Register("en", filepath.Join(dir, "en.json"))
+		require.ErrorIs(t, err, scanner.ErrMalformedPlural)
+		require.Empty(t, translator.Keys("en"))
+	})
+}
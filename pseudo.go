@@ -0,0 +1,52 @@
+package gotr
+
+import (
+	"errors"
+
+	"github.com/leoviggiano/gotr/internal/pseudo"
+)
+
+var (
+	errPseudoLocaleIsDefault = errors.New("pseudo locale identifier must differ from the default identifier")
+)
+
+// WithPseudoLocale registers identifier (e.g. "en-XA") as a pseudo locale generated from the default
+// locale: texts get accented, about 30% longer and wrapped in brackets, keeping their placeholders.
+// The pseudo locale is regenerated every time a file is registered for the default identifier.
+func WithPseudoLocale(identifier string) option {
+	return func(t *translator) error {
+		if identifier == t.defaultIdentifier {
+			return errPseudoLocaleIsDefault
+		}
+
+		t.pseudoIdentifier = identifier
+		t.generatePseudoLocale()
+		return nil
+	}
+}
+
+func (t *translator) generatePseudoLocale() {
+	if t.pseudoIdentifier == "" {
+		return
+	}
+
+	defaultTemplates, ok := t.templates[t.defaultIdentifier]
+	if !ok {
+		delete(t.templates, t.pseudoIdentifier)
+		return
+	}
+
+	templates := make(map[string]template, len(defaultTemplates))
+	for key, tpl := range defaultTemplates {
+		templates[key] = template{
+			Singular: pseudo.Localize(tpl.Singular),
+			Plural:   pseudo.Localize(tpl.Plural),
+			None:     pseudo.Localize(tpl.None),
+			path:     tpl.path,
+			source:   tpl.source,
+			metadata: tpl.metadata,
+		}
+	}
+
+	t.templates[t.pseudoIdentifier] = templates
+}
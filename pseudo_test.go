@@ -0,0 +1,82 @@
+package gotr
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithPseudoLocale(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		translator, err := NewTranslator(
+			WithPseudoLocale("en-XA"),
+			WithDefault("en", "./translations/en_US.json"),
+			WithDefault("en", "./translations/en_US_items.json"),
+		)
+		require.NoError(t, err)
+
+		tt := []struct {
+			name      string
+			localizer string
+			count     int
+			expected  string
+		}{
+			{name: "path", localizer: "hello_world", expected: "[Ĥéļļö Ŵöŕļð ~~~~]"},
+			{name: "text", localizer: "Hello World", expected: "[Ĥéļļö Ŵöŕļð ~~~~]"},
+			{name: "plural", localizer: "items.equipments.armor", count: 2, expected: "[John ĥåš 2 Åŕṁöŕš. ~~~~]"},
+			{name: "not found", localizer: "invalid.path", expected: "invalid.path"},
+		}
+
+		for _, tc := range tt {
+			t.Run(tc.name, func(t *testing.T) {
+				args := Args{
+					Identifier: "en-XA",
+					Localizer:  tc.localizer,
+					Count:      tc.count,
+					Args:       map[string]any{"Name": "John", "Count": tc.count},
+				}
+
+				require.Equal(t, tc.expected, translator.Get(args))
+			})
+		}
+	})
+
+	t.Run("metadata", func(t *testing.T) {
+		dir := t.TempDir()
+		writeFile(t, dir, "en.json", `{
+			"actions": {"open": {"singular": "Open", "msgctxt": "verb", "description": "Opens the door"}},
+			"states": {"open": {"singular": "Open", "msgctxt": "state"}}
+		}`)
+
+		tr, err := NewTranslator(WithPseudoLocale("en-XA"), WithDefault("en", filepath.Join(dir, "en.json")))
+		require.NoError(t, err)
+
+		templates := tr.(*translator).templates
+		require.Equal(t, Metadata{Description: "Opens the door", MessageContext: "verb"}, templates["en-XA"]["actions.open"].metadata)
+		require.Equal(t, templates["en"]["states.open"].metadata, templates["en-XA"]["states.open"].metadata)
+
+		require.Equal(t, "[Öþéñ ~~]", tr.Get(Args{Identifier: "en-XA", Localizer: "Open", Context: "verb"}))
+		require.Equal(t, "[Öþéñ ~~]", tr.Get(Args{Identifier: "en-XA", Localizer: "Open", Context: "state"}))
+	})
+
+	t.Run("error - same as default", func(t *testing.T) {
+		translator, err := NewTranslator(
+			WithDefault("en", "./translations/en_US.json"),
+			WithPseudoLocale("en"),
+		)
+		require.Equal(t, errPseudoLocaleIsDefault, err)
+		require.Nil(t, translator)
+	})
+
+	t.Run("error - default after the pseudo locale", func(t *testing.T) {
+		for _, option := range []option{WithDefault("en", "./translations/en_US.json"), WithDefaultIdentifier("en")} {
+			translator, err := NewTranslator(
+				WithPseudoLocale("en"),
+				option,
+			)
+			require.Equal(t, errPseudoLocaleIsDefault, err)
+			require.Nil(t, translator)
+		}
+	})
+}
@@ -0,0 +1,196 @@
+package gotr
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// defaultMaxReferenceDepth is how many levels of references are resolved unless WithMaxReferenceDepth
+// says otherwise.
+const defaultMaxReferenceDepth = 8
+
+var (
+	errReferenceCycle        = errors.New("reference cycle")
+	errReferenceDepth        = errors.New("references nested too deep")
+	errInvalidReferenceDepth = errors.New("max reference depth must be positive")
+	referencePattern         = regexp.MustCompile(`\{\{\s*t\s+"([^"]+)"\s*\}\}`)
+)
+
+// WithMaxReferenceDepth sets how many levels of {{t "key"}} references are resolved, 8 by default.
+// Registering a file whose references nest deeper fails. It must come before the options registering
+// files.
+func WithMaxReferenceDepth(depth int) option {
+	return func(t *translator) error {
+		if depth < 1 {
+			return fmt.Errorf("%w: %d", errInvalidReferenceDepth, depth)
+		}
+
+		if len(t.templates) > 0 {
+			return fmt.Errorf("%w: WithMaxReferenceDepth", errOptionAfterFiles)
+		}
+
+		t.maxReferenceDepth = depth
+		return nil
+	}
+}
+
+func (t *translator) referenceDepth() int {
+	if t.maxReferenceDepth == 0 {
+		return defaultMaxReferenceDepth
+	}
+
+	return t.maxReferenceDepth
+}
+
+// references returns the keys tpl references in any of its forms.
+func references(tpl template) []string {
+	var keys []string
+	for _, text := range []string{tpl.Singular, tpl.Plural, tpl.None} {
+		for _, match := range referencePattern.FindAllStringSubmatch(text, -1) {
+			if !slices.Contains(keys, match[1]) {
+				keys = append(keys, match[1])
+			}
+		}
+	}
+
+	return keys
+}
+
+// render applies args to the form of tpl for args.Count, once its references are resolved.
+func (t *translator) render(tpl template, args Args) string {
+	text := t.resolve(args.Identifier, tpl.text(args.Count), args.Count, 0)
+	return template{Singular: text, Plural: text, None: text}.apply(args)
+}
+
+// resolve replaces the {{t "key"}} references of text by the texts of their keys in identifier,
+// falling back to the default locale and then to the key itself. References nested deeper than the
+// max reference depth are left as they are.
+func (t *translator) resolve(identifier, text string, count, depth int) string {
+	if depth >= t.referenceDepth() {
+		return text
+	}
+
+	return referencePattern.ReplaceAllStringFunc(text, func(reference string) string {
+		key := referencePattern.FindStringSubmatch(reference)[1]
+
+		tpl, ok := t.find(identifier, key)
+		if !ok {
+			tpl, ok = t.find(t.defaultIdentifier, key)
+		}
+
+		if !ok {
+			return key
+		}
+
+		return t.resolve(identifier, tpl.text(count), count, depth+1)
+	})
+}
+
+// checkReferences reports references cycles, and references nested deeper than the max reference
+// depth, that registering incoming for identifier would create. Registering the default locale can
+// affect every locale, as references fall back to it.
+func (t *translator) checkReferences(identifier string, incoming map[string]template) error {
+	locales := []string{identifier}
+	if identifier == t.defaultIdentifier {
+		for locale := range t.templates {
+			if locale != identifier && locale != t.pseudoIdentifier {
+				locales = append(locales, locale)
+			}
+		}
+	}
+
+	for _, locale := range locales {
+		c := referenceCheck{
+			lookup:  t.referenceLookup(locale, identifier, incoming),
+			heights: make(map[string]int),
+		}
+
+		for _, key := range sortedTemplateKeys(incoming) {
+			height, err := c.height(key)
+			if err != nil {
+				return fmt.Errorf("%s: %w", locale, err)
+			}
+
+			if height > t.referenceDepth() {
+				return fmt.Errorf("%w: %s: %s nests %d levels of references, more than %d", errReferenceDepth, locale, key, height, t.referenceDepth())
+			}
+		}
+	}
+
+	return nil
+}
+
+// referenceLookup finds a referenced key the way resolve does, as if incoming was registered for
+// identifier.
+func (t *translator) referenceLookup(locale, identifier string, incoming map[string]template) func(string) (template, bool) {
+	find := func(locale, key string) (template, bool) {
+		if locale == identifier {
+			if tpl, ok := incoming[key]; ok {
+				return tpl, true
+			}
+		}
+
+		return t.find(locale, key)
+	}
+
+	return func(key string) (template, bool) {
+		if tpl, ok := find(locale, key); ok {
+			return tpl, true
+		}
+
+		return find(t.defaultIdentifier, key)
+	}
+}
+
+// referenceCheck walks the references of a locale, remembering how many levels of references every
+// key nests.
+type referenceCheck struct {
+	lookup   func(string) (template, bool)
+	heights  map[string]int
+	visiting []string
+}
+
+func (c *referenceCheck) height(key string) (int, error) {
+	if height, ok := c.heights[key]; ok {
+		return height, nil
+	}
+
+	if i := slices.Index(c.visiting, key); i >= 0 {
+		cycle := append(slices.Clone(c.visiting[i:]), key)
+		return 0, fmt.Errorf("%w: %s", errReferenceCycle, strings.Join(cycle, " -> "))
+	}
+
+	tpl, ok := c.lookup(key)
+	if !ok {
+		c.heights[key] = 0
+		return 0, nil
+	}
+
+	c.visiting = append(c.visiting, key)
+	height := 0
+	for _, reference := range references(tpl) {
+		h, err := c.height(reference)
+		if err != nil {
+			return 0, err
+		}
+
+		height = max(height, h+1)
+	}
+	c.visiting = c.visiting[:len(c.visiting)-1]
+
+	c.heights[key] = height
+	return height, nil
+}
+
+func sortedTemplateKeys(templates map[string]template) []string {
+	keys := make([]string, 0, len(templates))
+	for key := range templates {
+		keys = append(keys, key)
+	}
+
+	slices.Sort(keys)
+	return keys
+}
@@ -0,0 +1,109 @@
+package gotr
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTranslator_References(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, dir, "en.json", `{
+		"brand": {"name": "Gotr", "team": "{{t \"brand.name\"}} Team"},
+		"texts": {
+			"welcome": "Welcome to {{t \"brand.name\"}}, {{.Name}}!",
+			"signature": "The {{t \"brand.team\"}}",
+			"missing": "See {{ t \"texts.unknown\" }}"
+		},
+		"items": {"armor": {"singular": "{{.Count}} Armor", "plural": "{{.Count}} Armors", "none": "No Armor"}},
+		"inventory": "Inventory: {{t \"items.armor\"}}"
+	}`)
+	writeFile(t, dir, "pt.json", `{
+		"brand": {"team": "Equipe {{t \"brand.name\"}}"},
+		"texts": {"welcome": "Bem-vindo ao {{t \"brand.name\"}}, {{.Name}}!", "signature": "A {{t \"brand.team\"}}"},
+		"items": {"armor": {"singular": "{{.Count}} Armadura", "plural": "{{.Count}} Armaduras", "none": "Sem Armadura"}},
+		"inventory": "Inventário: {{t \"items.armor\"}}"
+	}`)
+
+	translator, err := NewTranslator(WithDefault("en", filepath.Join(dir, "en.json")))
+	require.NoError(t, err)
+
+	err = translator.Register("pt", filepath.Join(dir, "pt.json"))
+	require.NoError(t, err)
+
+	tt := []struct {
+		name     string
+		args     Args
+		expected string
+	}{
+		{name: "reference", args: Args{Identifier: "en", Localizer: "texts.welcome", Args: map[string]any{"Name": "John"}}, expected: "Welcome to Gotr, John!"},
+		{name: "reference falls back to default", args: Args{Identifier: "pt", Localizer: "texts.welcome", Args: map[string]any{"Name": "John"}}, expected: "Bem-vindo ao Gotr, John!"},
+		{name: "nested references", args: Args{Identifier: "pt", Localizer: "texts.signature"}, expected: "A Equipe Gotr"},
+		{name: "unknown reference", args: Args{Identifier: "en", Localizer: "texts.missing"}, expected: "See texts.unknown"},
+		{name: "plural reference", args: Args{Identifier: "pt", Localizer: "inventory", Args: map[string]any{"Count": 2}, Count: 2}, expected: "Inventário: 2 Armaduras"},
+		{name: "text as key", args: Args{Identifier: "pt", Localizer: "The {{t \"brand.team\"}}"}, expected: "A Equipe Gotr"},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, translator.Get(tc.args))
+		})
+	}
+}
+
+func TestTranslator_ReferenceErrors(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, dir, "en.json", `{"a": "A", "b": "{{t \"a\"}}"}`)
+	writeFile(t, dir, "self.json", `{"c": "C {{t \"c\"}}"}`)
+	writeFile(t, dir, "cycle.json", `{"c": "{{t \"d\"}}", "d": "{{t \"e\"}}", "e": "{{t \"c\"}}"}`)
+	writeFile(t, dir, "fallback_cycle.json", `{"a": "{{t \"b\"}}"}`)
+	writeFile(t, dir, "deep.json", `{"c": "{{t \"d\"}}", "d": "{{t \"e\"}}", "e": "{{t \"b\"}}"}`)
+
+	tt := []struct {
+		name string
+		file string
+		err  error
+	}{
+		{name: "self reference", file: "self.json", err: errReferenceCycle},
+		{name: "cycle", file: "cycle.json", err: errReferenceCycle},
+		{name: "cycle through the default locale", file: "fallback_cycle.json", err: errReferenceCycle},
+		{name: "too deep", file: "deep.json", err: errReferenceDepth},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			translator, err := NewTranslator(
+				WithMaxReferenceDepth(3),
+				WithDefault("en", filepath.Join(dir, "en.json")),
+			)
+			require.NoError(t, err)
+
+			err = translator.Register("pt", filepath.Join(dir, tc.file))
+			require.ErrorIs(t, err, tc.err)
+			require.Empty(t, translator.Keys("pt"))
+		})
+	}
+
+	t.Run("replace keeps the locale", func(t *testing.T) {
+		translator, err := NewTranslator(WithDefault("en", filepath.Join(dir, "en.json")))
+		require.NoError(t, err)
+
+		err = translator.Replace("en", filepath.Join(dir, "self.json"))
+		require.ErrorIs(t, err, errReferenceCycle)
+		require.Equal(t, []string{"a", "b"}, translator.Keys("en"))
+		require.Equal(t, "A", translator.Get(Args{Identifier: "en", Localizer: "b"}))
+	})
+
+	t.Run("invalid depth", func(t *testing.T) {
+		translator, err := NewTranslator(WithMaxReferenceDepth(0))
+		require.ErrorIs(t, err, errInvalidReferenceDepth)
+		require.Nil(t, translator)
+	})
+
+	t.Run("after a file option", func(t *testing.T) {
+		translator, err := NewTranslator(WithDefault("en", filepath.Join(dir, "en.json")), WithMaxReferenceDepth(3))
+		require.ErrorIs(t, err, errOptionAfterFiles)
+		require.Nil(t, translator)
+	})
+}
@@ -0,0 +1,97 @@
+package gotr
+
+import (
+	"math"
+	"sort"
+)
+
+// LocaleStats is the translation progress of a locale against the key paths of the default locale.
+// Every key is either translated, missing or identical to the source, and the percentages are
+// relative to Total.
+type LocaleStats struct {
+	Identifier string `json:"identifier"`
+	Total      int    `json:"total"`
+
+	Translated                int     `json:"translated"`
+	TranslatedPercent         float64 `json:"translatedPercent"`
+	Missing                   int     `json:"missing"`
+	MissingPercent            float64 `json:"missingPercent"`
+	Identical                 int     `json:"identical"` // Same text as the default locale, likely untranslated
+	IdenticalPercent          float64 `json:"identicalPercent"`
+	MissingPluralForms        int     `json:"missingPluralForms"` // Keys lacking plural forms the default locale has
+	MissingPluralFormsPercent float64 `json:"missingPluralFormsPercent"`
+}
+
+// Stats returns the translation progress of every registered locale but the default and the pseudo
+// locale, sorted by identifier.
+func (t *translator) Stats() []LocaleStats {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	defaultTemplates := t.templates[t.defaultIdentifier]
+
+	stats := []LocaleStats{}
+	for identifier, templates := range t.templates {
+		if identifier == t.defaultIdentifier || identifier == t.pseudoIdentifier {
+			continue
+		}
+
+		s := LocaleStats{Identifier: identifier}
+		for key, source := range defaultTemplates {
+			if source.path != key {
+				continue
+			}
+
+			s.Total++
+
+			target, ok := templates[key]
+			switch {
+			case !ok || target.path != key:
+				s.Missing++
+				continue
+			case target.forms() == source.forms():
+				s.Identical++
+			default:
+				s.Translated++
+			}
+
+			if missingForms(source, target) {
+				s.MissingPluralForms++
+			}
+		}
+
+		s.TranslatedPercent = percent(s.Translated, s.Total)
+		s.MissingPercent = percent(s.Missing, s.Total)
+		s.IdenticalPercent = percent(s.Identical, s.Total)
+		s.MissingPluralFormsPercent = percent(s.MissingPluralForms, s.Total)
+
+		stats = append(stats, s)
+	}
+
+	sort.Slice(stats, func(i, j int) bool {
+		return stats[i].Identifier < stats[j].Identifier
+	})
+
+	return stats
+}
+
+// missingForms reports whether target lacks plural forms source has.
+func missingForms(source, target template) bool {
+	if source.plain() {
+		return false
+	}
+
+	return target.plain() ||
+		(target.Singular == "" && source.Singular != "") ||
+		(target.Plural == "" && source.Plural != "") ||
+		(target.None == "" && source.None != "")
+}
+
+// percent returns part of total as a percentage rounded to one decimal.
+func percent(part, total int) float64 {
+	if total == 0 {
+		return 0
+	}
+
+	return math.Round(float64(part)*1000/float64(total)) / 10
+}
@@ -0,0 +1,67 @@
+package gotr
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTranslator_Stats(t *testing.T) {
+	translator, err := NewTranslator(
+		WithDefault("en", "./translations/en_US.json"),
+		WithDefault("en", "./translations/en_US_items.json"),
+		WithPseudoLocale("en-XA"),
+	)
+	require.NoError(t, err)
+
+	err = translator.Register("pt", "./translations/pt_BR.json")
+	require.NoError(t, err)
+
+	err = translator.RegisterYAML("es", strings.NewReader(`
+hello_world: Hello World
+texts:
+  welcome: ¡Bienvenido!
+items:
+  equipments:
+    armor: Armadura
+  consumables:
+    mana-potion:
+      singular: "{{.Name}} tiene {{.Count}} Poción de maná."
+      plural: "{{.Name}} tiene {{.Count}} Pociones de maná."
+`))
+	require.NoError(t, err)
+
+	require.Equal(t, []LocaleStats{
+		{
+			Identifier:                "es",
+			Total:                     7,
+			Translated:                3,
+			TranslatedPercent:         42.9,
+			Missing:                   3,
+			MissingPercent:            42.9,
+			Identical:                 1,
+			IdenticalPercent:          14.3,
+			MissingPluralForms:        2,
+			MissingPluralFormsPercent: 28.6,
+		},
+		{
+			Identifier:        "pt",
+			Total:             7,
+			Translated:        6,
+			TranslatedPercent: 85.7,
+			Missing:           1,
+			MissingPercent:    14.3,
+		},
+	}, translator.Stats())
+
+	t.Run("without default", func(t *testing.T) {
+		translator, err := NewTranslator()
+		require.NoError(t, err)
+
+		err = translator.Register("pt", "./translations/pt_BR.json")
+		require.NoError(t, err)
+
+		require.Equal(t, []LocaleStats{{Identifier: "pt"}}, translator.Stats())
+	})
+}
@@ -4,12 +4,20 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+
+	"github.com/leoviggiano/gotr/internal/catalog"
+	"github.com/leoviggiano/gotr/internal/metadata"
+	"github.com/leoviggiano/gotr/internal/scanner"
 )
 
 type template struct {
 	Singular string `json:"singular"`
 	Plural   string `json:"plural"`
 	None     string `json:"none"`
+
+	path     string            // key path the template was registered with
+	source   string            // file the template was registered from, empty for readers
+	metadata metadata.Metadata // metadata of a message object
 }
 
 var (
@@ -17,31 +25,62 @@ var (
 	errInvalidValue = errors.New("invalid value")
 )
 
-func newTemplate(data []byte) (template, error) {
-	var tpl template
-	err := json.Unmarshal(data, &tpl)
+// newTemplate builds a template from the JSON of a key path, reading the plural forms from the keys
+// forms names and the metadata of plural blocks. A block with only a singular form is a plain text.
+func newTemplate(data []byte, forms scanner.Forms) (template, error) {
+	var values map[string]any
+	err := json.Unmarshal(data, &values)
 	if err != nil {
 		return template{}, fmt.Errorf("%w: %v", errInvalidJSON, err)
 	}
 
-	if tpl.empty() {
-		value, err := tpl.extractValue(data)
+	var tpl template
+	found := 0
+	for form, text := range map[string]*string{forms.Singular: &tpl.Singular, forms.Plural: &tpl.Plural, forms.None: &tpl.None} {
+		v, ok := values[form]
+		if !ok {
+			continue
+		}
+
+		found++
+		*text, ok = v.(string)
+		if !ok {
+			return template{}, fmt.Errorf("%w: form %s must be a string: value: %v", errInvalidValue, form, v)
+		}
+	}
+
+	if found > 0 {
+		if tpl.empty() {
+			return template{}, fmt.Errorf("%w: forms are empty: %s", errInvalidValue, string(data))
+		}
+
+		// A message object with only a singular form is a plain text carrying metadata.
+		if _, ok := values[forms.Singular]; ok && found == 1 {
+			tpl.Plural, tpl.None = tpl.Singular, tpl.Singular
+		}
+
+		tpl.metadata, err = metadata.Parse(values)
 		if err != nil {
 			return template{}, err
 		}
 
-		if value == "" {
-			return template{}, fmt.Errorf("%w: value is empty: %s", errInvalidValue, string(data))
-		}
+		return tpl, nil
+	}
+
+	value, err := tpl.extractValue(data)
+	if err != nil {
+		return template{}, err
+	}
 
-		return template{
-			Singular: value,
-			Plural:   value,
-			None:     value,
-		}, nil
+	if value == "" {
+		return template{}, fmt.Errorf("%w: value is empty: %s", errInvalidValue, string(data))
 	}
 
-	return tpl, nil
+	return template{
+		Singular: value,
+		Plural:   value,
+		None:     value,
+	}, nil
 }
 
 func (t template) extractValue(data []byte) (string, error) {
@@ -64,20 +103,29 @@ func (t template) extractValue(data []byte) (string, error) {
 }
 
 func (t template) apply(args Args) string {
-	var str string
+	return args.apply(fmt.Sprintf(t.text(args.Count)))
+}
 
-	switch args.Count {
+// text returns the form used for count.
+func (t template) text(count int) string {
+	switch count {
 	case 0:
-		str = fmt.Sprintf(t.None)
+		return t.None
 	case 1:
-		str = fmt.Sprintf(t.Singular)
+		return t.Singular
 	default:
-		str = fmt.Sprintf(t.Plural)
+		return t.Plural
 	}
-
-	return args.apply(str)
 }
 
 func (t template) empty() bool {
 	return (t.Singular == "" && t.Plural == "" && t.None == "")
 }
+
+func (t template) plain() bool {
+	return t.Singular == t.Plural && t.Singular == t.None
+}
+
+func (t template) forms() catalog.Forms {
+	return catalog.Forms{Singular: t.Singular, Plural: t.Plural, None: t.None}
+}
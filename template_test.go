@@ -5,6 +5,8 @@ import (
 	"errors"
 	"testing"
 
+	"github.com/leoviggiano/gotr/internal/metadata"
+	"github.com/leoviggiano/gotr/internal/scanner"
 	"github.com/stretchr/testify/require"
 )
 
@@ -18,7 +20,7 @@ func TestNewTemplate(t *testing.T) {
 			"none": "{{.Name}} has no Armor."
 		}`)
 
-		tpl, err := newTemplate(jsonTemplate)
+		tpl, err := newTemplate(jsonTemplate, scanner.DefaultForms)
 		require.NoError(t, err)
 
 		jsonMap := map[string]any{}
@@ -28,12 +30,13 @@ func TestNewTemplate(t *testing.T) {
 		require.Equal(t, tpl.Singular, jsonMap["singular"])
 		require.Equal(t, tpl.Plural, jsonMap["plural"])
 		require.Equal(t, tpl.None, jsonMap["none"])
+		require.Equal(t, Metadata{Description: "Armor text"}, tpl.metadata)
 	})
 
 	t.Run("success - only key/value data", func(t *testing.T) {
 		jsonTemplate := []byte(`{"key": "value"}`)
 
-		tpl, err := newTemplate(jsonTemplate)
+		tpl, err := newTemplate(jsonTemplate, scanner.DefaultForms)
 		require.NoError(t, err)
 
 		require.Equal(t, tpl.Singular, "value")
@@ -41,6 +44,15 @@ func TestNewTemplate(t *testing.T) {
 		require.Equal(t, tpl.None, "value")
 	})
 
+	t.Run("success - only singular form", func(t *testing.T) {
+		jsonTemplate := []byte(`{"singular": "Open", "msgctxt": "verb"}`)
+
+		tpl, err := newTemplate(jsonTemplate, scanner.DefaultForms)
+		require.NoError(t, err)
+
+		require.Equal(t, template{Singular: "Open", Plural: "Open", None: "Open", metadata: Metadata{MessageContext: "verb"}}, tpl)
+	})
+
 	ttErrors := []struct {
 		name          string
 		jsonData      []byte
@@ -50,11 +62,14 @@ func TestNewTemplate(t *testing.T) {
 		{name: "error extract value: many levels json", jsonData: []byte(`{"value": { "test": "value" }}`), expectedError: errInvalidValue},
 		{name: "empty json", jsonData: []byte(`{}`), expectedError: errInvalidValue},
 		{name: "empty value", jsonData: []byte(`{"value": ""}`), expectedError: errInvalidValue},
+		{name: "form not a string", jsonData: []byte(`{"singular": 1}`), expectedError: errInvalidValue},
+		{name: "empty forms", jsonData: []byte(`{"singular": "", "description": "Armor text"}`), expectedError: errInvalidValue},
+		{name: "invalid metadata", jsonData: []byte(`{"singular": "Armor", "maxLength": "short"}`), expectedError: metadata.ErrInvalidMetadata},
 	}
 
 	for _, tt := range ttErrors {
 		t.Run(tt.name, func(t *testing.T) {
-			tpl, err := newTemplate(tt.jsonData)
+			tpl, err := newTemplate(tt.jsonData, scanner.DefaultForms)
 			require.Error(t, err)
 			require.True(t, errors.Is(err, tt.expectedError))
 			require.Equal(t, template{}, tpl)
@@ -1,22 +1,61 @@
 package gotr
 
 import (
-	"encoding/json"
 	"errors"
-	"os"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+	"sync"
 
+	"github.com/leoviggiano/gotr/internal/catalog"
 	"github.com/leoviggiano/gotr/internal/parser"
 	"github.com/leoviggiano/gotr/internal/scanner"
 )
 
 type Translator interface {
-	Register(identifier, jsonPath string) error
+	Register(identifier, path string, options ...registerOption) error
+	RegisterYAML(identifier string, r io.Reader, options ...registerOption) error
+	RegisterDir(dir string, options ...registerOption) error
 	Get(args Args) string
+	GetList(args Args) []string
+	Locales() []string
+	Default() string
+	Keys(identifier string) []string
+	Template(identifier, key string) (TemplateInfo, bool)
+	Namespaces(identifier string) []string
+	UnloadNamespace(identifier, namespace string)
+	ExportPO(identifier string, w io.Writer) error
+	ExportAndroid(identifier string, w io.Writer) error
+	ExportStrings(identifier string, w io.Writer) error
+	ExportStringsdict(identifier string, w io.Writer) error
+	ExportARB(identifier string, w io.Writer) error
+	ExportProperties(identifier string, w io.Writer) error
+	ExportCSV(w io.Writer) error
+	ExportTSV(w io.Writer) error
+	ValidatePlaceholders() []PlaceholderIssue
+	Stats() []LocaleStats
+	Conflicts() []Conflict
+	Collisions() []Collision
+	Unregister(identifier string)
+	Replace(identifier, path string, options ...registerOption) error
 }
 
 type translator struct {
-	defaultIdentifier string
-	templates         map[string]map[string]template
+	defaultIdentifier   string
+	pseudoIdentifier    string
+	filePattern         *regexp.Regexp
+	fallbackNamespaces  []string
+	mergePolicy         MergePolicy
+	forms               scanner.Forms
+	maxReferenceDepth   int
+	normalizeWhitespace bool
+	conflicts           []Conflict
+	collisions          []Collision
+
+	mu         sync.RWMutex // guards templates, namespaces, conflicts and collisions
+	templates  map[string]map[string]template
+	namespaces map[string]map[string]struct{}
 }
 
 type option func(*translator) error
@@ -31,6 +70,10 @@ func WithDefault(identifier, jsonPath string) option {
 			return errDefaultAlreadyRegistered
 		}
 
+		if t.pseudoIdentifier != "" && identifier == t.pseudoIdentifier {
+			return errPseudoLocaleIsDefault
+		}
+
 		t.defaultIdentifier = identifier
 		return t.Register(identifier, jsonPath)
 	}
@@ -38,7 +81,8 @@ func WithDefault(identifier, jsonPath string) option {
 
 func NewTranslator(options ...option) (Translator, error) {
 	t := &translator{
-		templates: make(map[string]map[string]template),
+		templates:  make(map[string]map[string]template),
+		namespaces: make(map[string]map[string]struct{}),
 	}
 
 	for _, option := range options {
@@ -51,77 +95,172 @@ func NewTranslator(options ...option) (Translator, error) {
 	return t, nil
 }
 
-func (t *translator) Register(identifier, jsonPath string) error {
-	file, err := os.ReadFile(jsonPath)
+// registration holds the options of a single Register call.
+type registration struct {
+	namespace string
+	source    string
+}
+
+type registerOption func(*registration)
+
+// key returns the key path is registered under.
+func (r registration) key(path string) string {
+	if r.namespace == "" {
+		return path
+	}
+
+	return r.namespace + namespaceSeparator + path
+}
+
+func withSource(source string) registerOption {
+	return func(r *registration) {
+		r.source = source
+	}
+}
+
+// Register loads the translation file at path for identifier. The format is detected by the file
+// extension: ".yaml" and ".yml" files are read as YAML, ".po" and ".mo" files as gettext catalogs,
+// ".xml" files as Android string resources, ".strings" and ".stringsdict" files as Apple string
+// tables, ".arb" files as Flutter ARB, ".properties" files as Java properties, anything else as JSON.
+func (t *translator) Register(identifier, path string, options ...registerOption) error {
+	v, err := catalog.Load(path)
+	if err != nil {
+		return err
+	}
+
+	return t.register(identifier, v, append([]registerOption{withSource(path)}, options...))
+}
+
+// RegisterYAML reads a YAML translation catalog from r for identifier.
+func (t *translator) RegisterYAML(identifier string, r io.Reader, options ...registerOption) error {
+	v, err := catalog.DecodeYAML(r)
 	if err != nil {
 		return err
 	}
 
-	var v map[string]any
-	err = json.Unmarshal(file, &v)
+	return t.register(identifier, v, options)
+}
+
+func (t *translator) register(identifier string, v map[string]any, options []registerOption) error {
+	reg, tpls, err := t.parseTemplates(v, options)
 	if err != nil {
 		return err
 	}
 
-	jsonTree := scanner.Scan(v)
+	t.mu.Lock()
+	defer t.mu.Unlock()
 
-	translator, ok := t.templates[identifier]
-	if !ok {
-		translator = make(map[string]template)
-		t.templates[identifier] = translator
+	return t.add(identifier, reg, tpls)
+}
+
+// parseTemplates builds the templates of every key path of v, keyed as the options describe.
+func (t *translator) parseTemplates(v map[string]any, options []registerOption) (registration, []template, error) {
+	var reg registration
+	for _, option := range options {
+		option(&reg)
+	}
+
+	if strings.Contains(reg.namespace, namespaceSeparator) {
+		return reg, nil, fmt.Errorf("%w: %q", errInvalidNamespace, reg.namespace)
+	}
+
+	forms := t.pluralForms()
+	jsonTree, err := scanner.Scan(v, forms)
+	if err != nil {
+		return reg, nil, err
 	}
 
+	tpls := make([]template, 0, len(jsonTree))
 	for _, path := range jsonTree {
 		k, err := parser.Parse(v, path)
 		if err != nil {
-			return err
+			return reg, nil, err
 		}
 
-		tpl, err := newTemplate(k)
+		tpl, err := newTemplate(k, forms)
 		if err != nil {
-			return err
+			return reg, nil, err
+		}
+		tpl.path = reg.key(path)
+		tpl.source = reg.source
+
+		tpls = append(tpls, tpl)
+	}
+
+	return reg, tpls, nil
+}
+
+// add merges tpls into the templates of identifier, along with their text-as-key entries.
+func (t *translator) add(identifier string, reg registration, tpls []template) error {
+	conflicts := t.findConflicts(identifier, tpls)
+	if len(conflicts) > 0 && t.mergePolicy == MergeError {
+		return conflictError(conflicts)
+	}
+
+	skip := make(map[string]bool)
+	if t.mergePolicy == MergeFirstWins {
+		for _, c := range conflicts {
+			skip[c.Key] = true
+		}
+	}
+
+	incoming := make(map[string]template, len(tpls))
+	for _, tpl := range tpls {
+		if !skip[tpl.path] {
+			incoming[tpl.path] = tpl
 		}
+	}
+
+	if err := t.checkReferences(identifier, incoming); err != nil {
+		return err
+	}
 
-		translator[path] = tpl
+	t.conflicts = append(t.conflicts, conflicts...)
 
-		if t.defaultIdentifier == identifier {
-			translator[tpl.Singular] = tpl
+	translator, ok := t.templates[identifier]
+	if !ok {
+		translator = make(map[string]template)
+		t.templates[identifier] = translator
+	}
+
+	for _, tpl := range tpls {
+		if skip[tpl.path] {
+			continue
 		}
 
-		defaultTemplate, ok := t.templates[t.defaultIdentifier][path]
-		if ok {
-			translator[defaultTemplate.Singular] = tpl
+		translator[tpl.path] = tpl
+	}
+
+	if reg.namespace != "" {
+		if t.namespaces[identifier] == nil {
+			t.namespaces[identifier] = make(map[string]struct{})
 		}
+
+		t.namespaces[identifier][reg.namespace] = struct{}{}
 	}
 
+	t.relink(identifier)
 	return nil
 }
 
 // Get the translation by the given path or text and identifier.
 func (t *translator) Get(args Args) string {
-	identifiedTranslator, ok := t.templates[args.Identifier]
-	if !ok {
-		return t.defaultGet(args)
-	}
+	t.mu.RLock()
+	defer t.mu.RUnlock()
 
-	template, ok := identifiedTranslator[args.Localizer]
+	template, ok := t.lookup(args.Identifier, args)
 	if !ok {
 		return t.defaultGet(args)
 	}
 
-	return template.apply(args)
+	return t.render(template, args)
 }
 
 func (t *translator) defaultGet(args Args) string {
-	identifiedTranslator, ok := t.templates[t.defaultIdentifier]
-	if !ok {
-		return args.apply(args.Localizer)
-	}
-
-	template, ok := identifiedTranslator[args.Localizer]
+	template, ok := t.lookup(t.defaultIdentifier, args)
 	if !ok {
 		return args.apply(args.Localizer)
 	}
 
-	return template.apply(args)
+	return t.render(template, args)
 }
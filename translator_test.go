@@ -1,6 +1,9 @@
 package gotr
 
 import (
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -106,6 +109,57 @@ func TestTranslator_Register(t *testing.T) {
 	})
 }
 
+func TestTranslator_RegisterYAML(t *testing.T) {
+	ptYAML := `
+# Portuguese texts
+hello_world: Olá Mundo
+items:
+  equipments:
+    armor:
+      singular: "{{.Name}} tem {{.Count}} Armadura."
+      plural: "{{.Name}} tem {{.Count}} Armaduras."
+      none: "{{.Name}} não tem Armadura."
+`
+
+	t.Run("success - reader", func(t *testing.T) {
+		translator, err := NewTranslator(
+			WithDefault("en", "./translations/en_US.json"),
+			WithDefault("en", "./translations/en_US_items.json"),
+		)
+		require.NoError(t, err)
+
+		err = translator.RegisterYAML("pt", strings.NewReader(ptYAML))
+		require.NoError(t, err)
+
+		require.Equal(t, "Olá Mundo", translator.Get(Args{Identifier: "pt", Localizer: "Hello World"}))
+		require.Equal(t, "John não tem Armadura.", translator.Get(Args{
+			Identifier: "pt",
+			Localizer:  "items.equipments.armor",
+			Args:       map[string]any{"Name": "John"},
+		}))
+	})
+
+	t.Run("success - file extension", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "pt_BR.yml")
+		require.NoError(t, os.WriteFile(path, []byte(ptYAML), 0o600))
+
+		translator, err := NewTranslator(WithDefault("en", "./translations/en_US.json"))
+		require.NoError(t, err)
+
+		err = translator.Register("pt", path)
+		require.NoError(t, err)
+		require.Equal(t, "Olá Mundo", translator.Get(Args{Identifier: "pt", Localizer: "hello_world"}))
+	})
+
+	t.Run("error", func(t *testing.T) {
+		translator, err := NewTranslator()
+		require.NoError(t, err)
+
+		err = translator.RegisterYAML("pt", strings.NewReader("- invalid"))
+		require.Error(t, err)
+	})
+}
+
 func TestTranslator_Get(t *testing.T) {
 	translator, err := NewTranslator(
 		WithDefault("en", "./translations/en_US.json"),
@@ -146,6 +200,31 @@ func TestTranslator_Get(t *testing.T) {
 	}
 }
 
+func TestTranslator_GetEscapedPath(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, dir, "en.json", `{"links": {"example.com": "Visit example.com"}, "versions": {"v1.2": "Version 1.2"}}`)
+
+	translator, err := NewTranslator(WithDefault("en", filepath.Join(dir, "en.json")))
+	require.NoError(t, err)
+
+	tt := []struct {
+		name      string
+		localizer string
+		expected  string
+	}{
+		{name: "escaped dot", localizer: `links.example\.com`, expected: "Visit example.com"},
+		{name: "path segments", localizer: Path("versions", "v1.2"), expected: "Version 1.2"},
+		{name: "unescaped dot", localizer: "versions.v1.2", expected: "versions.v1.2"},
+		{name: "text", localizer: "Version 1.2", expected: "Version 1.2"},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.expected, translator.Get(Args{Identifier: "en", Localizer: test.localizer}))
+		})
+	}
+}
+
 func TestTranslator_defaultGet(t *testing.T) {
 	translator := translator{
 		defaultIdentifier: "en",
@@ -0,0 +1,71 @@
+package gotr
+
+import (
+	"slices"
+
+	"github.com/leoviggiano/gotr/internal/catalog"
+)
+
+// Unregister removes identifier with all its templates. Unregistering the default locale also
+// removes the text-as-key entries the other locales derived from its texts, and the pseudo locale.
+func (t *translator) Unregister(identifier string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.remove(identifier)
+
+	if identifier == t.defaultIdentifier {
+		t.reindex()
+	}
+}
+
+// Replace swaps the templates of identifier for the ones of the file at path in one step, so keys
+// missing from the file are gone and concurrent calls to Get see either the old or the new locale.
+// Replacing the default locale rebuilds the text-as-key entries of the other locales.
+func (t *translator) Replace(identifier, path string, options ...registerOption) error {
+	v, err := catalog.Load(path)
+	if err != nil {
+		return err
+	}
+
+	reg, tpls, err := t.parseTemplates(v, append([]registerOption{withSource(path)}, options...))
+	if err != nil {
+		return err
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	templates, namespaces, conflicts := t.templates[identifier], t.namespaces[identifier], slices.Clone(t.conflicts)
+
+	t.remove(identifier)
+	if err := t.add(identifier, reg, tpls); err != nil {
+		// A file that can't be registered leaves the locale as it was.
+		if templates != nil {
+			t.templates[identifier] = templates
+		}
+
+		if namespaces != nil {
+			t.namespaces[identifier] = namespaces
+		}
+
+		t.conflicts = conflicts
+		return err
+	}
+
+	return nil
+}
+
+// remove deletes the templates, namespaces and conflicts of identifier.
+func (t *translator) remove(identifier string) {
+	delete(t.templates, identifier)
+	delete(t.namespaces, identifier)
+
+	conflicts := t.conflicts[:0]
+	for _, c := range t.conflicts {
+		if c.Identifier != identifier {
+			conflicts = append(conflicts, c)
+		}
+	}
+	t.conflicts = conflicts
+}
@@ -0,0 +1,111 @@
+package gotr
+
+import (
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTranslator_Unregister(t *testing.T) {
+	newTranslator := func(t *testing.T) Translator {
+		translator, err := NewTranslator(
+			WithDefault("en", "./translations/en_US.json"),
+			WithDefault("en", "./translations/en_US_items.json"),
+			WithPseudoLocale("en-XA"),
+		)
+		require.NoError(t, err)
+
+		err = translator.Register("pt", "./translations/pt_BR.json")
+		require.NoError(t, err)
+
+		return translator
+	}
+
+	t.Run("locale", func(t *testing.T) {
+		translator := newTranslator(t)
+		translator.Unregister("pt")
+		translator.Unregister("missing")
+
+		require.Equal(t, "Hello World", translator.Get(Args{Identifier: "pt", Localizer: "hello_world"}))
+		require.Equal(t, "Hello World", translator.Get(Args{Identifier: "pt", Localizer: "Hello World"}))
+	})
+
+	t.Run("default locale", func(t *testing.T) {
+		translator := newTranslator(t)
+		translator.Unregister("en")
+
+		require.Equal(t, "Olá Mundo", translator.Get(Args{Identifier: "pt", Localizer: "hello_world"}))
+		require.Equal(t, "Hello World", translator.Get(Args{Identifier: "pt", Localizer: "Hello World"}))
+		require.Equal(t, "hello_world", translator.Get(Args{Identifier: "en-XA", Localizer: "hello_world"}))
+	})
+}
+
+func TestTranslator_Replace(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, dir, "pt.json", `{"hello_world": "Oi Mundo"}`)
+	writeFile(t, dir, "en.json", `{"hello_world": "Hi World", "texts": {"welcome": "Welcome!"}}`)
+	writeFile(t, dir, "invalid.json", `{`)
+
+	translator, err := NewTranslator(
+		WithDefault("en", "./translations/en_US.json"),
+		WithDefault("en", "./translations/en_US_items.json"),
+		WithPseudoLocale("en-XA"),
+	)
+	require.NoError(t, err)
+
+	err = translator.Register("pt", "./translations/pt_BR.json")
+	require.NoError(t, err)
+
+	t.Run("locale", func(t *testing.T) {
+		err := translator.Replace("pt", filepath.Join(dir, "pt.json"))
+		require.NoError(t, err)
+
+		require.Equal(t, "Oi Mundo", translator.Get(Args{Identifier: "pt", Localizer: "hello_world"}))
+		require.Equal(t, "Oi Mundo", translator.Get(Args{Identifier: "pt", Localizer: "Hello World"}))
+		require.Equal(t, "Goodbye!", translator.Get(Args{Identifier: "pt", Localizer: "texts.goodbye"}))
+		require.Equal(t, "Goodbye!", translator.Get(Args{Identifier: "pt", Localizer: "Goodbye!"}))
+	})
+
+	t.Run("default locale", func(t *testing.T) {
+		err := translator.Replace("en", filepath.Join(dir, "en.json"))
+		require.NoError(t, err)
+
+		require.Equal(t, "Oi Mundo", translator.Get(Args{Identifier: "pt", Localizer: "Hi World"}))
+		require.Equal(t, "Hello World", translator.Get(Args{Identifier: "pt", Localizer: "Hello World"}))
+		require.Equal(t, "texts.goodbye", translator.Get(Args{Identifier: "en", Localizer: "texts.goodbye"}))
+		require.Equal(t, "[Ĥî Ŵöŕļð ~~~]", translator.Get(Args{Identifier: "en-XA", Localizer: "Hi World"}))
+	})
+
+	t.Run("invalid file keeps the locale", func(t *testing.T) {
+		err := translator.Replace("pt", filepath.Join(dir, "invalid.json"))
+		require.Error(t, err)
+
+		err = translator.Replace("pt", filepath.Join(dir, "missing.json"))
+		require.Error(t, err)
+
+		require.Equal(t, "Oi Mundo", translator.Get(Args{Identifier: "pt", Localizer: "hello_world"}))
+	})
+
+	t.Run("concurrent gets", func(t *testing.T) {
+		var wg sync.WaitGroup
+		for i := 0; i < 8; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for j := 0; j < 100; j++ {
+					text := translator.Get(Args{Identifier: "pt", Localizer: "hello_world"})
+					require.Contains(t, []string{"Oi Mundo", "Olá Mundo"}, text)
+				}
+			}()
+		}
+
+		for i := 0; i < 10; i++ {
+			require.NoError(t, translator.Replace("pt", "./translations/pt_BR.json"))
+			require.NoError(t, translator.Replace("pt", filepath.Join(dir, "pt.json")))
+		}
+
+		wg.Wait()
+	})
+}